@@ -31,6 +31,15 @@ const (
 	Query_TraceTx_FullMethodName          = "/ethermint.evm.v1.Query/TraceTx"
 	Query_TraceBlock_FullMethodName       = "/ethermint.evm.v1.Query/TraceBlock"
 	Query_BaseFee_FullMethodName          = "/ethermint.evm.v1.Query/BaseFee"
+	Query_Contracts_FullMethodName        = "/ethermint.evm.v1.Query/Contracts"
+	Query_Config_FullMethodName           = "/ethermint.evm.v1.Query/Config"
+	Query_EthChainID_FullMethodName       = "/ethermint.evm.v1.Query/EthChainID"
+	Query_TxReceipt_FullMethodName        = "/ethermint.evm.v1.Query/TxReceipt"
+	Query_AccountDump_FullMethodName      = "/ethermint.evm.v1.Query/AccountDump"
+	Query_LegacyParams_FullMethodName     = "/ethermint.evm.v1.Query/LegacyParams"
+	Query_PendingNonce_FullMethodName     = "/ethermint.evm.v1.Query/PendingNonce"
+	Query_ParamsAtHeight_FullMethodName   = "/ethermint.evm.v1.Query/ParamsAtHeight"
+	Query_ContractCreation_FullMethodName = "/ethermint.evm.v1.Query/ContractCreation"
 )
 
 // QueryClient is the client API for Query service.
@@ -64,6 +73,29 @@ type QueryClient interface {
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// Contracts queries the addresses of all the accounts holding contract code.
+	Contracts(ctx context.Context, in *QueryContractsRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error)
+	// Config queries the effective limits of the EVM execution environment.
+	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// EthChainID queries the cosmos chain id and the EIP155 chain id derived from it, as returned by
+	// the `eth_chainId` rpc api.
+	EthChainID(ctx context.Context, in *QueryChainIDRequest, opts ...grpc.CallOption) (*QueryChainIDResponse, error)
+	// TxReceipt queries the stored receipt of an executed ethereum transaction.
+	TxReceipt(ctx context.Context, in *QueryTxReceiptRequest, opts ...grpc.CallOption) (*QueryTxReceiptResponse, error)
+	// AccountDump queries the balance, nonce, code and storage of an account at
+	// once.
+	AccountDump(ctx context.Context, in *QueryAccountDumpRequest, opts ...grpc.CallOption) (*QueryAccountDumpResponse, error)
+	// LegacyParams queries the parameters reconstructed from the legacy x/params
+	// subspace. It is only served by nodes enabling it for debugging.
+	LegacyParams(ctx context.Context, in *QueryLegacyParamsRequest, opts ...grpc.CallOption) (*QueryLegacyParamsResponse, error)
+	// PendingNonce queries the next nonce of an account, including the
+	// transactions accepted by the mempool of the node but not committed yet.
+	PendingNonce(ctx context.Context, in *QueryPendingNonceRequest, opts ...grpc.CallOption) (*QueryPendingNonceResponse, error)
+	// ParamsAtHeight queries the parameters of the module in effect at a given
+	// height, as recorded by the params changes history.
+	ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error)
+	// ContractCreation queries the transaction that created a contract.
+	ContractCreation(ctx context.Context, in *QueryContractCreationRequest, opts ...grpc.CallOption) (*QueryContractCreationResponse, error)
 }
 
 type queryClient struct {
@@ -182,6 +214,87 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Contracts(ctx context.Context, in *QueryContractsRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error) {
+	out := new(QueryContractsResponse)
+	err := c.cc.Invoke(ctx, Query_Contracts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error) {
+	out := new(QueryConfigResponse)
+	err := c.cc.Invoke(ctx, Query_Config_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EthChainID(ctx context.Context, in *QueryChainIDRequest, opts ...grpc.CallOption) (*QueryChainIDResponse, error) {
+	out := new(QueryChainIDResponse)
+	err := c.cc.Invoke(ctx, Query_EthChainID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxReceipt(ctx context.Context, in *QueryTxReceiptRequest, opts ...grpc.CallOption) (*QueryTxReceiptResponse, error) {
+	out := new(QueryTxReceiptResponse)
+	err := c.cc.Invoke(ctx, Query_TxReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountDump(ctx context.Context, in *QueryAccountDumpRequest, opts ...grpc.CallOption) (*QueryAccountDumpResponse, error) {
+	out := new(QueryAccountDumpResponse)
+	err := c.cc.Invoke(ctx, Query_AccountDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LegacyParams(ctx context.Context, in *QueryLegacyParamsRequest, opts ...grpc.CallOption) (*QueryLegacyParamsResponse, error) {
+	out := new(QueryLegacyParamsResponse)
+	err := c.cc.Invoke(ctx, Query_LegacyParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingNonce(ctx context.Context, in *QueryPendingNonceRequest, opts ...grpc.CallOption) (*QueryPendingNonceResponse, error) {
+	out := new(QueryPendingNonceResponse)
+	err := c.cc.Invoke(ctx, Query_PendingNonce_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error) {
+	out := new(QueryParamsAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractCreation(ctx context.Context, in *QueryContractCreationRequest, opts ...grpc.CallOption) (*QueryContractCreationResponse, error) {
+	out := new(QueryContractCreationResponse)
+	err := c.cc.Invoke(ctx, Query_ContractCreation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +326,29 @@ type QueryServer interface {
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// Contracts queries the addresses of all the accounts holding contract code.
+	Contracts(context.Context, *QueryContractsRequest) (*QueryContractsResponse, error)
+	// Config queries the effective limits of the EVM execution environment.
+	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// EthChainID queries the cosmos chain id and the EIP155 chain id derived from it, as returned by
+	// the `eth_chainId` rpc api.
+	EthChainID(context.Context, *QueryChainIDRequest) (*QueryChainIDResponse, error)
+	// TxReceipt queries the stored receipt of an executed ethereum transaction.
+	TxReceipt(context.Context, *QueryTxReceiptRequest) (*QueryTxReceiptResponse, error)
+	// AccountDump queries the balance, nonce, code and storage of an account at
+	// once.
+	AccountDump(context.Context, *QueryAccountDumpRequest) (*QueryAccountDumpResponse, error)
+	// LegacyParams queries the parameters reconstructed from the legacy x/params
+	// subspace. It is only served by nodes enabling it for debugging.
+	LegacyParams(context.Context, *QueryLegacyParamsRequest) (*QueryLegacyParamsResponse, error)
+	// PendingNonce queries the next nonce of an account, including the
+	// transactions accepted by the mempool of the node but not committed yet.
+	PendingNonce(context.Context, *QueryPendingNonceRequest) (*QueryPendingNonceResponse, error)
+	// ParamsAtHeight queries the parameters of the module in effect at a given
+	// height, as recorded by the params changes history.
+	ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error)
+	// ContractCreation queries the transaction that created a contract.
+	ContractCreation(context.Context, *QueryContractCreationRequest) (*QueryContractCreationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -256,6 +392,33 @@ func (UnimplementedQueryServer) TraceBlock(context.Context, *QueryTraceBlockRequ
 func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
+func (UnimplementedQueryServer) Contracts(context.Context, *QueryContractsRequest) (*QueryContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contracts not implemented")
+}
+func (UnimplementedQueryServer) Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+func (UnimplementedQueryServer) EthChainID(context.Context, *QueryChainIDRequest) (*QueryChainIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthChainID not implemented")
+}
+func (UnimplementedQueryServer) TxReceipt(context.Context, *QueryTxReceiptRequest) (*QueryTxReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxReceipt not implemented")
+}
+func (UnimplementedQueryServer) AccountDump(context.Context, *QueryAccountDumpRequest) (*QueryAccountDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountDump not implemented")
+}
+func (UnimplementedQueryServer) LegacyParams(context.Context, *QueryLegacyParamsRequest) (*QueryLegacyParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LegacyParams not implemented")
+}
+func (UnimplementedQueryServer) PendingNonce(context.Context, *QueryPendingNonceRequest) (*QueryPendingNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNonce not implemented")
+}
+func (UnimplementedQueryServer) ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAtHeight not implemented")
+}
+func (UnimplementedQueryServer) ContractCreation(context.Context, *QueryContractCreationRequest) (*QueryContractCreationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCreation not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -485,6 +648,168 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Contracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Contracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Contracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contracts(ctx, req.(*QueryContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Config_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Config(ctx, req.(*QueryConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EthChainID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthChainID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EthChainID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthChainID(ctx, req.(*QueryChainIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TxReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxReceipt(ctx, req.(*QueryTxReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountDump(ctx, req.(*QueryAccountDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LegacyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLegacyParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LegacyParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LegacyParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LegacyParams(ctx, req.(*QueryLegacyParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNonce(ctx, req.(*QueryPendingNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAtHeight(ctx, req.(*QueryParamsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCreation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractCreationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCreation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractCreation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCreation(ctx, req.(*QueryContractCreationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -540,6 +865,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "Contracts",
+			Handler:    _Query_Contracts_Handler,
+		},
+		{
+			MethodName: "Config",
+			Handler:    _Query_Config_Handler,
+		},
+		{
+			MethodName: "EthChainID",
+			Handler:    _Query_EthChainID_Handler,
+		},
+		{
+			MethodName: "TxReceipt",
+			Handler:    _Query_TxReceipt_Handler,
+		},
+		{
+			MethodName: "AccountDump",
+			Handler:    _Query_AccountDump_Handler,
+		},
+		{
+			MethodName: "LegacyParams",
+			Handler:    _Query_LegacyParams_Handler,
+		},
+		{
+			MethodName: "PendingNonce",
+			Handler:    _Query_PendingNonce_Handler,
+		},
+		{
+			MethodName: "ParamsAtHeight",
+			Handler:    _Query_ParamsAtHeight_Handler,
+		},
+		{
+			MethodName: "ContractCreation",
+			Handler:    _Query_ContractCreation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_EthereumTx_FullMethodName   = "/ethermint.evm.v1.Msg/EthereumTx"
 	Msg_UpdateParams_FullMethodName = "/ethermint.evm.v1.Msg/UpdateParams"
+	Msg_ResetParams_FullMethodName  = "/ethermint.evm.v1.Msg/ResetParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +33,9 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResetParams defines a governance operation resetting the x/evm module parameters to their
+	// default values, the evm denom and the chain config are kept unless requested otherwise.
+	ResetParams(ctx context.Context, in *MsgResetParams, opts ...grpc.CallOption) (*MsgResetParamsResponse, error)
 }
 
 type msgClient struct {
@@ -60,6 +64,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ResetParams(ctx context.Context, in *MsgResetParams, opts ...grpc.CallOption) (*MsgResetParamsResponse, error) {
+	out := new(MsgResetParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -69,6 +82,9 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResetParams defines a governance operation resetting the x/evm module parameters to their
+	// default values, the evm denom and the chain config are kept unless requested otherwise.
+	ResetParams(context.Context, *MsgResetParams) (*MsgResetParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -82,6 +98,9 @@ func (UnimplementedMsgServer) EthereumTx(context.Context, *MsgEthereumTx) (*MsgE
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ResetParams(context.Context, *MsgResetParams) (*MsgResetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -131,6 +150,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetParams(ctx, req.(*MsgResetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +183,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ResetParams",
+			Handler:    _Msg_ResetParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"pgregory.net/rapid"
 
@@ -18,7 +19,6 @@ import (
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	"cosmossdk.io/x/tx/signing/aminojson"
 	signing_testutil "cosmossdk.io/x/tx/signing/testutil"
-	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
@@ -69,6 +69,7 @@ func TestAminoJSON_Equivalence(t *testing.T) {
 
 		// evm
 		GenType(&evmtypes.MsgUpdateParams{}, &evmv1.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&evmtypes.MsgResetParams{}, &evmv1.MsgResetParams{}, GenOpts.WithDisallowNil()),
 		GenType(&evmtypes.Params{}, &evmv1.Params{}, GenOpts.WithDisallowNil()),
 
 		// feemarket
@@ -92,7 +93,7 @@ func TestAminoJSON_Equivalence(t *testing.T) {
 				// }()
 
 				msg := gen.Draw(t, "msg")
-				postFixPulsarMessage(msg)
+				postFixPulsarMessage(t, msg)
 
 				gogo := tt.Gogo
 				sanity := tt.Pulsar
@@ -159,15 +160,92 @@ func TestAminoJSON_Equivalence(t *testing.T) {
 	}
 }
 
-func postFixPulsarMessage(msg proto.Message) {
+// addressFields are the string fields of the tested messages holding a bech32 address.
+var addressFields = map[protoreflect.FullName]bool{
+	"ethermint.evm.v1.MsgUpdateParams.authority":       true,
+	"ethermint.evm.v1.MsgResetParams.authority":        true,
+	"ethermint.feemarket.v1.MsgUpdateParams.authority": true,
+}
+
+// drawAddress draws a bech32 account address from the rapid seed, so a failing case can be
+// reproduced.
+func drawAddress(t *rapid.T, label string) string {
+	bz := rapid.SliceOfN(rapid.Byte(), 20, 20).Draw(t, label)
+	text, err := bech32.ConvertAndEncode("cosmos", bz)
+	require.NoError(t, err)
+	return text
+}
+
+// setAddressFields walks the message and sets every known address field to a valid bech32 address
+// drawn from the rapid seed, so that the legacy and the new encoders see the same well-formed input.
+func setAddressFields(t *rapid.T, msg protoreflect.Message) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case addressFields[fd.FullName()] && fd.Kind() == protoreflect.StringKind && !fd.IsList():
+			// an empty address is an unset field, so always overwrite it
+			msg.Set(fd, protoreflect.ValueOfString(drawAddress(t, string(fd.FullName()))))
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() && msg.Has(fd):
+			setAddressFields(t, msg.Get(fd).Message())
+		}
+	}
+}
+
+func TestPostFixPulsarMessage_Addresses(t *testing.T) {
+	encCfg := testutil.MakeTestEncodingConfig(
+		evm.AppModuleBasic{},
+		feemarket.AppModuleBasic{})
+	aj := aminojson.NewEncoder(aminojson.EncoderOptions{DoNotSortFields: true})
+
+	genOpts := rapidproto.GeneratorOptions{
+		Resolver:  protoregistry.GlobalTypes,
+		FieldMaps: []rapidproto.FieldMapper{GeneratorFieldMapper},
+	}.WithDisallowNil()
+	gen := rapidproto.MessageGenerator(&feemarketv1.MsgUpdateParams{}, genOpts)
+
+	for i := 0; i < 10; i++ {
+		rapid.Check(t, func(t *rapid.T) {
+			msg := gen.Draw(t, "msg")
+			postFixPulsarMessage(t, msg)
+
+			hrp, bz, err := bech32.DecodeAndConvert(msg.Authority)
+			require.NoError(t, err)
+			require.Equal(t, "cosmos", hrp)
+			require.Len(t, bz, 20)
+
+			protoBz, err := proto.Marshal(msg)
+			require.NoError(t, err)
+			gogo := &feemarkettypes.MsgUpdateParams{}
+			require.NoError(t, encCfg.Codec.Unmarshal(protoBz, gogo))
+			require.Equal(t, msg.Authority, gogo.Authority)
+
+			legacyAminoJSON, err := encCfg.Amino.MarshalJSON(gogo)
+			require.NoError(t, err)
+			aminoJSON, err := aj.Marshal(msg)
+			require.NoError(t, err)
+			require.Equal(t, string(legacyAminoJSON), string(aminoJSON))
+
+			// the address survives the amino JSON round trip
+			decoded := &feemarkettypes.MsgUpdateParams{}
+			require.NoError(t, encCfg.Amino.UnmarshalJSON(legacyAminoJSON, decoded))
+			require.Equal(t, msg.Authority, decoded.Authority)
+			addr, err := types.AccAddressFromBech32(decoded.Authority)
+			require.NoError(t, err)
+			require.Equal(t, bz, addr.Bytes())
+		})
+	}
+}
+
+func postFixPulsarMessage(t *rapid.T, msg proto.Message) {
+	setAddressFields(t, msg.ProtoReflect())
+
 	if m, ok := msg.(*authapi.ModuleAccount); ok {
 		if m.BaseAccount == nil {
 			m.BaseAccount = &authapi.BaseAccount{}
 		}
-		_, _, bz := testdata.KeyTestPubAddr()
 		// always set address to a valid bech32 address
-		text, _ := bech32.ConvertAndEncode("cosmos", bz)
-		m.BaseAccount.Address = text
+		m.BaseAccount.Address = drawAddress(t, "module_account_address")
 
 		// see negative test
 		if len(m.Permissions) == 0 {
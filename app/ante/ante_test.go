@@ -1347,6 +1347,61 @@ func (suite AnteTestSuite) TestAnteHandlerWithParams() {
 	suite.evmParamsOption = nil
 }
 
+func (suite AnteTestSuite) TestAnteHandlerWithMaxTxGasLimit() {
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		maxGas   uint64
+		gasLimit uint64
+		expErr   error
+	}{
+		{"fail - gas limit above the max", 100000, 100001, evmtypes.ErrTxGasLimitExceeded},
+		{"success - gas limit at the max", 100000, 100000, nil},
+		{"success - zero max disables the check", 0, 1000000, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.evmParamsOption = func(params *evmtypes.Params) {
+				params.MaxTxGasLimit = tc.maxGas
+			}
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			suite.ctx = suite.ctx.WithIsCheckTx(true)
+			suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt((ethparams.InitialBaseFee+10)*int64(tc.gasLimit)))
+
+			signedTx := evmtypes.NewTx(
+				suite.app.EvmKeeper.ChainID(),
+				1,
+				&to,
+				big.NewInt(10),
+				tc.gasLimit,
+				nil,
+				big.NewInt(ethparams.InitialBaseFee+1),
+				big.NewInt(1),
+				nil,
+				&types.AccessList{},
+			)
+			signedTx.From = addr.Hex()
+			tx := suite.CreateTestTx(signedTx, privKey, 1, false)
+
+			_, err := suite.anteHandler(suite.ctx, tx, false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+	suite.evmParamsOption = nil
+}
+
 func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	params := authtypes.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
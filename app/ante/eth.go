@@ -309,7 +309,7 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		// NOTE: here the gas consumed is from the context with the infinite gas meter
 		if coreMsg.Value().Sign() > 0 && !evm.Context().CanTransfer(stateDB, coreMsg.From(), coreMsg.Value()) {
 			return ctx, errorsmod.Wrapf(
-				errortypes.ErrInsufficientFunds,
+				evmtypes.NewInsufficientFundsError(coreMsg.Value(), stateDB.GetBalance(coreMsg.From())),
 				"failed to transfer %s from address %s using the EVM block context transfer function",
 				coreMsg.Value(),
 				coreMsg.From(),
@@ -320,6 +320,80 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// EthSenderTxLimitDecorator limits the number of ethereum transactions a sender can include in a
+// block.
+type EthSenderTxLimitDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthSenderTxLimitDecorator creates a new EthSenderTxLimitDecorator.
+func NewEthSenderTxLimitDecorator(ek EVMKeeper) EthSenderTxLimitDecorator {
+	return EthSenderTxLimitDecorator{
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle counts the transactions of each sender in the current block and rejects the ones
+// exceeding the MaxSenderTxsPerBlock param. Simulations aren't counted.
+func (stld EthSenderTxLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	limit := stld.evmKeeper.GetParams(ctx).MaxSenderTxsPerBlock
+	if simulate || limit == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		sender := common.BytesToAddress(msgEthTx.GetFrom())
+		if err := stld.evmKeeper.CheckSenderTxLimit(ctx, sender, limit); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthPendingNonceDecorator records the next nonces of the senders of the transactions accepted by
+// the mempool, they're served by the PendingNonce query.
+type EthPendingNonceDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthPendingNonceDecorator creates a new EthPendingNonceDecorator.
+func NewEthPendingNonceDecorator(ek EVMKeeper) EthPendingNonceDecorator {
+	return EthPendingNonceDecorator{
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle records the nonces on CheckTx once the rest of the ante handler succeeded, so the
+// transactions rejected by the mempool aren't taken into account.
+func (pnd EthPendingNonceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil || !ctx.IsCheckTx() || simulate {
+		return newCtx, err
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		pnd.evmKeeper.SetPendingNonce(common.BytesToAddress(msgEthTx.GetFrom()), txData.GetNonce()+1)
+	}
+
+	return newCtx, nil
+}
+
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
 	ak evmtypes.AccountKeeper
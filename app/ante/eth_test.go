@@ -5,7 +5,9 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/evmos/ethermint/app/ante"
 	"github.com/evmos/ethermint/server/config"
@@ -362,21 +364,25 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 	var vmdb *statedb.StateDB
 
 	testCases := []struct {
-		name     string
-		tx       sdk.Tx
-		malleate func()
-		expPass  bool
+		name        string
+		tx          sdk.Tx
+		malleate    func()
+		expPass     bool
+		expFundsErr *evmtypes.InsufficientFundsError
 	}{
-		{"invalid transaction type", &invalidTx{}, func() {}, false},
-		{"AsMessage failed", tx2, func() {}, false},
+		{"invalid transaction type", &invalidTx{}, func() {}, false, nil},
+		{"AsMessage failed", tx2, func() {}, false, nil},
 		{
 			"evm CanTransfer failed",
 			tx,
 			func() {
 				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
 				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				vmdb.AddBalance(addr, big.NewInt(5))
 			},
 			false,
+			evmtypes.NewInsufficientFundsError(big.NewInt(10), big.NewInt(5)),
 		},
 		{
 			"success",
@@ -388,6 +394,7 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 				vmdb.AddBalance(addr, big.NewInt(1000000))
 			},
 			true,
+			nil,
 		},
 	}
 
@@ -404,10 +411,97 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 			} else {
 				suite.Require().Error(err)
 			}
+			if tc.expFundsErr != nil {
+				suite.Require().ErrorIs(err, evmtypes.ErrInsufficientFunds)
+				var fundsErr *evmtypes.InsufficientFundsError
+				suite.Require().ErrorAs(err, &fundsErr)
+				suite.Require().Equal(tc.expFundsErr, fundsErr)
+
+				codespace, code, _ := errorsmod.ABCIInfo(err, false)
+				suite.Require().Equal(evmtypes.ModuleName, codespace)
+				suite.Require().Equal(evmtypes.ErrInsufficientFunds.ABCICode(), code)
+			}
 		})
 	}
 }
 
+func (suite AnteTestSuite) TestEthSenderTxLimitDecorator() {
+	dec := ante.NewEthSenderTxLimitDecorator(suite.app.EvmKeeper)
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	newTx := func(nonce uint64) *evmtypes.MsgEthereumTx {
+		tx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &to, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+		tx.From = addr.Hex()
+		suite.Require().NoError(tx.Sign(suite.ethSigner, tests.NewSigner(privKey)))
+		return tx
+	}
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.MaxSenderTxsPerBlock = 2
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	defer func() {
+		params.MaxSenderTxsPerBlock = 0
+		suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	}()
+
+	ctx, _ := suite.ctx.CacheContext()
+
+	// simulations aren't counted
+	_, err := dec.AnteHandle(ctx, newTx(0), true, NextFn)
+	suite.Require().NoError(err)
+
+	for nonce := uint64(0); nonce < params.MaxSenderTxsPerBlock; nonce++ {
+		_, err = dec.AnteHandle(ctx, newTx(nonce), false, NextFn)
+		suite.Require().NoError(err)
+	}
+	_, err = dec.AnteHandle(ctx, newTx(params.MaxSenderTxsPerBlock), false, NextFn)
+	suite.Require().ErrorIs(err, evmtypes.ErrSenderTxLimitExceeded)
+
+	_, err = dec.AnteHandle(ctx, &invalidTx{}, false, NextFn)
+	suite.Require().Error(err)
+}
+
+func (suite AnteTestSuite) TestEthPendingNonceDecorator() {
+	dec := ante.NewEthPendingNonceDecorator(suite.app.EvmKeeper)
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	newTx := func(nonce uint64) *evmtypes.MsgEthereumTx {
+		tx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &to, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+		tx.From = addr.Hex()
+		suite.Require().NoError(tx.Sign(suite.ethSigner, tests.NewSigner(privKey)))
+		return tx
+	}
+	defer suite.app.EvmKeeper.ResetPendingNonces()
+
+	checkCtx := suite.ctx.WithIsCheckTx(true)
+	failFn := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, errortypes.ErrInvalidSequence
+	}
+
+	// only the txs accepted on CheckTx are recorded
+	_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(false), newTx(5), false, NextFn)
+	suite.Require().NoError(err)
+	_, err = dec.AnteHandle(checkCtx, newTx(5), true, NextFn)
+	suite.Require().NoError(err)
+	_, err = dec.AnteHandle(checkCtx, newTx(5), false, failFn)
+	suite.Require().Error(err)
+	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetPendingNonce(suite.ctx, addr))
+
+	_, err = dec.AnteHandle(checkCtx, newTx(5), false, NextFn)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(6), suite.app.EvmKeeper.GetPendingNonce(suite.ctx, addr))
+
+	// a lower nonce doesn't replace the recorded one
+	_, err = dec.AnteHandle(checkCtx, newTx(2), false, NextFn)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(6), suite.app.EvmKeeper.GetPendingNonce(suite.ctx, addr))
+
+	suite.app.EvmKeeper.ResetPendingNonces()
+	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetPendingNonce(suite.ctx, addr))
+}
+
 func (suite AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
 	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
 	addr, privKey := tests.NewAddrKey()
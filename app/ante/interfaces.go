@@ -49,6 +49,8 @@ type EVMKeeper interface {
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	CheckSenderTxLimit(ctx sdk.Context, sender common.Address, limit uint64) error
+	SetPendingNonce(addr common.Address, nonce uint64)
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
 
@@ -177,6 +177,9 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid From %s, expect empty string", msgEthTx.From)
 		}
 
+		if err := evmParams.CheckTxGasLimit(msgEthTx.GetGas()); err != nil {
+			return ctx, err
+		}
 		txGasLimit += msgEthTx.GetGas()
 
 		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
@@ -28,7 +28,6 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cast"
-	protov2 "google.golang.org/protobuf/proto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmos "github.com/cometbft/cometbft/libs/os"
@@ -128,8 +127,8 @@ import (
 	// unnamed import of statik for swagger UI support
 	_ "github.com/evmos/ethermint/client/docs/statik"
 
-	evmv1 "github.com/evmos/ethermint/api/ethermint/evm/v1"
 	"github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/encoding"
 	enccodec "github.com/evmos/ethermint/encoding/codec"
 	"github.com/evmos/ethermint/ethereum/eip712"
 	srvflags "github.com/evmos/ethermint/server/flags"
@@ -261,8 +260,7 @@ func NewEthermintApp(
 		},
 	}
 
-	// evm/MsgEthereumTx
-	signingOptions.DefineCustomGetSigners(protov2.MessageName(&evmv1.MsgEthereumTx{}), evmtypes.GetSignersFromMsgEthereumTxV2)
+	encoding.RegisterEVMSigningOptions(&signingOptions)
 
 	interfaceRegistry, _ := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
 		ProtoFiles:     proto.HybridResolver,
@@ -570,6 +568,13 @@ func NewEthermintApp(
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
 		nil, geth.NewEVM, tracer, evmSs,
 	)
+	app.EvmKeeper.SetGasCap(cast.ToUint64(appOpts.Get(srvflags.EVMGasCap)))
+	app.EvmKeeper.SetStrictExtraEIPs(cast.ToBool(appOpts.Get(srvflags.EVMStrictExtraEIPs)))
+	app.EvmKeeper.SetLegacyParamsQuery(cast.ToBool(appOpts.Get(srvflags.EVMLegacyParamsQuery)))
+	if querier, ok := app.CommitMultiStore().(storetypes.Queryable); ok {
+		app.EvmKeeper.SetProofQuerier(querier)
+	}
+	app.EvmKeeper.SetHistoricalStore(app.CommitMultiStore())
 
 	/****  Module Options ****/
 
@@ -697,6 +702,9 @@ func NewEthermintApp(
 		consensusparamtypes.ModuleName,
 	)
 
+	// NOTE: evm module resets the pending nonces before the mempool txs are rechecked.
+	app.ModuleManager.SetOrderPrepareCheckStaters(evmtypes.ModuleName)
+
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
@@ -780,6 +788,7 @@ func NewEthermintApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrepareCheckStater(app.PrepareCheckStater)
 	app.setAnteHandler(txConfig, cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted)))
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
@@ -881,6 +890,13 @@ func (app *EthermintApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	return app.ModuleManager.EndBlock(ctx)
 }
 
+// PrepareCheckStater updates the check state of the app after a block is committed
+func (app *EthermintApp) PrepareCheckStater(ctx sdk.Context) {
+	if err := app.ModuleManager.PrepareCheckState(ctx); err != nil {
+		panic(err)
+	}
+}
+
 func (app *EthermintApp) Configurator() module.Configurator {
 	return app.configurator
 }
@@ -1,17 +1,23 @@
 package app
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
 	"github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/log"
 	dbm "github.com/cosmos/cosmos-db"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestEthermintAppExport(t *testing.T) {
@@ -40,3 +46,25 @@ func TestEthermintAppExport(t *testing.T) {
 	_, err = app2.ExportAppStateAndValidators(false, []string{}, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
+
+func TestSetupWithGenesis(t *testing.T) {
+	// the app isn't initialized in check tx mode, it's only used for the codec and module addresses
+	tmpApp := Setup(true, nil)
+	feeCollector := tmpApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
+	var bankGenesis banktypes.GenesisState
+	tmpApp.AppCodec().MustUnmarshalJSON(NewTestGenesisState(tmpApp.AppCodec())[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: feeCollector.String(),
+		Coins:   coins,
+	})
+	bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+
+	app := SetupWithGenesis(false, map[string]json.RawMessage{
+		banktypes.ModuleName: tmpApp.AppCodec().MustMarshalJSON(&bankGenesis),
+	})
+	ctx := app.BaseApp.NewContextLegacy(false, tmproto.Header{ChainID: ChainID})
+
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+}
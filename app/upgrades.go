@@ -43,6 +43,8 @@ import (
 	clientkeeper "github.com/cosmos/ibc-go/v8/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctmmigrations "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint/migrations"
+	"github.com/evmos/ethermint/x/evm"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 )
@@ -94,6 +96,14 @@ func (app *EthermintApp) RegisterUpgradeHandlers(
 		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 			sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+			// the evm migrations are picked from the format of the stored params rather than from the
+			// recorded consensus version, which may not match it
+			evmMigrator := evmkeeper.NewMigrator(*app.EvmKeeper, app.GetSubspace(evmtypes.ModuleName))
+			if err := evmMigrator.RunEVMMigrations(sdkCtx); err != nil {
+				return fromVM, err
+			}
+			fromVM[evmtypes.ModuleName] = evm.AppModuleBasic{}.ConsensusVersion()
+
 			if fromVM, err := app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM); err != nil {
 				return fromVM, err
 			}
@@ -64,6 +64,17 @@ func Setup(isCheckTx bool, patchGenesis func(*EthermintApp, simapp.GenesisState)
 	return SetupWithDB(isCheckTx, patchGenesis, dbm.NewMemDB())
 }
 
+// SetupWithGenesis initializes a new EthermintApp, the given per-module genesis states replace the
+// default ones of the test genesis state. A Nop logger is set in EthermintApp.
+func SetupWithGenesis(isCheckTx bool, modGenesis map[string]json.RawMessage) *EthermintApp {
+	return Setup(isCheckTx, func(_ *EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		for moduleName, moduleGenesis := range modGenesis {
+			genesis[moduleName] = moduleGenesis
+		}
+		return genesis
+	})
+}
+
 const ChainID = "ethermint_9000-1"
 
 // SetupWithDB initializes a new EthermintApp. A Nop logger is set in EthermintApp.
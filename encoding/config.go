@@ -32,6 +32,13 @@ import (
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
+// RegisterEVMSigningOptions defines the custom signers of the evm messages on the given signing
+// options, so that apps building their own interface registry can resolve them.
+func RegisterEVMSigningOptions(opts *signing.Options) {
+	// evm/MsgEthereumTx
+	opts.DefineCustomGetSigners(protov2.MessageName(&evmv1.MsgEthereumTx{}), evmtypes.GetSignersFromMsgEthereumTxV2)
+}
+
 // MakeTestEncodingConfig creates an EncodingConfig for testing
 func MakeTestEncodingConfig(modules ...module.AppModuleBasic) params.EncodingConfig {
 	cdc := amino.NewLegacyAmino()
@@ -41,8 +48,7 @@ func MakeTestEncodingConfig(modules ...module.AppModuleBasic) params.EncodingCon
 		ValidatorAddressCodec: address.Bech32Codec{Bech32Prefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix()},
 	}
 
-	// evm/MsgEthereumTx
-	signingOptions.DefineCustomGetSigners(protov2.MessageName(&evmv1.MsgEthereumTx{}), evmtypes.GetSignersFromMsgEthereumTxV2)
+	RegisterEVMSigningOptions(&signingOptions)
 
 	interfaceRegistry, _ := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
 		ProtoFiles:     proto.HybridResolver,
@@ -65,3 +71,31 @@ func MakeTestEncodingConfig(modules ...module.AppModuleBasic) params.EncodingCon
 	mb.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 	return encodingConfig
 }
+
+// MakeConfigFrom creates an EncodingConfig from an existing legacy amino codec and interface
+// registry, registering the ethermint types and the ones of the given modules on them. It can be
+// called several times with the same instances: the types are only registered when MsgEthereumTx
+// doesn't resolve on the registry yet, as registering the same concrete type twice on a legacy amino
+// codec panics. The basic manager is expected to include the evm module, and the registry to be
+// created with the options set by RegisterEVMSigningOptions.
+func MakeConfigFrom(cdc *amino.LegacyAmino, registry types.InterfaceRegistry, mb module.BasicManager) params.EncodingConfig {
+	codec := amino.NewProtoCodec(registry)
+
+	encodingConfig := params.EncodingConfig{
+		InterfaceRegistry: registry,
+		Codec:             codec,
+		TxConfig:          tx.NewTxConfig(codec, tx.DefaultSignModes),
+		Amino:             cdc,
+	}
+
+	if _, err := registry.Resolve(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})); err == nil {
+		// already configured by a previous call
+		return encodingConfig
+	}
+
+	enccodec.RegisterLegacyAminoCodec(cdc)
+	mb.RegisterLegacyAminoCodec(cdc)
+	enccodec.RegisterInterfaces(registry)
+	mb.RegisterInterfaces(registry)
+	return encodingConfig
+}
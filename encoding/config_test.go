@@ -4,14 +4,24 @@ import (
 	"math/big"
 	"testing"
 
+	"cosmossdk.io/simapp/params"
+	"cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/evmos/ethermint/encoding"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/evmos/ethermint/x/feemarket"
 )
 
 func TestTxEncoding(t *testing.T) {
@@ -37,3 +47,71 @@ func TestTxEncoding(t *testing.T) {
 
 	// require.Equal(t, txHash.Bytes(), tmTx.Hash())
 }
+
+func TestRegisterEVMSigningOptions(t *testing.T) {
+	addr, key := tests.NewAddrKey()
+
+	msg := evmtypes.NewTx(big.NewInt(1), 1, &common.Address{}, big.NewInt(10), 100000, big.NewInt(1), nil, nil, nil, nil)
+	msg.From = addr.Hex()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(big.NewInt(1)), tests.NewSigner(key)))
+
+	signingOptions := signing.Options{
+		AddressCodec:          address.Bech32Codec{Bech32Prefix: sdk.GetConfig().GetBech32AccountAddrPrefix()},
+		ValidatorAddressCodec: address.Bech32Codec{Bech32Prefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix()},
+	}
+	encoding.RegisterEVMSigningOptions(&signingOptions)
+
+	interfaceRegistry, err := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
+		ProtoFiles:     proto.HybridResolver,
+		SigningOptions: signingOptions,
+	})
+	require.NoError(t, err)
+	evmtypes.RegisterInterfaces(interfaceRegistry)
+
+	signers, _, err := codec.NewProtoCodec(interfaceRegistry).GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{addr.Bytes()}, signers)
+}
+
+func TestMakeConfigFrom(t *testing.T) {
+	newInterfaceRegistry := func() types.InterfaceRegistry {
+		signingOptions := signing.Options{
+			AddressCodec:          address.Bech32Codec{Bech32Prefix: sdk.GetConfig().GetBech32AccountAddrPrefix()},
+			ValidatorAddressCodec: address.Bech32Codec{Bech32Prefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix()},
+		}
+		encoding.RegisterEVMSigningOptions(&signingOptions)
+		interfaceRegistry, err := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
+			ProtoFiles:     proto.HybridResolver,
+			SigningOptions: signingOptions,
+		})
+		require.NoError(t, err)
+		return interfaceRegistry
+	}
+	interfaceRegistry := newInterfaceRegistry()
+	cdc := codec.NewLegacyAmino()
+	mb := module.NewBasicManager(evm.AppModuleBasic{}, feemarket.AppModuleBasic{})
+
+	var cfg params.EncodingConfig
+	for i := 0; i < 2; i++ {
+		require.NotPanics(t, func() {
+			cfg = encoding.MakeConfigFrom(cdc, interfaceRegistry, mb)
+		})
+	}
+	require.Equal(t, interfaceRegistry, cfg.InterfaceRegistry)
+	require.Equal(t, cdc, cfg.Amino)
+
+	resolved, err := interfaceRegistry.Resolve(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}))
+	require.NoError(t, err)
+	require.IsType(t, &evmtypes.MsgEthereumTx{}, resolved)
+
+	// other instances are configured independently
+	otherRegistry, otherCdc := newInterfaceRegistry(), codec.NewLegacyAmino()
+	require.NotPanics(t, func() {
+		encoding.MakeConfigFrom(otherCdc, otherRegistry, mb)
+	})
+	_, err = otherRegistry.Resolve(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}))
+	require.NoError(t, err)
+	bz, err := otherCdc.MarshalJSON(evmtypes.DefaultParams())
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"type":"ethermint/x/evm/Params"`)
+}
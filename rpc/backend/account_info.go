@@ -51,7 +51,11 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 	return res.Code, nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs.
+//
+// NOTE: the proofs are ICS-23 merkle proofs against the cosmos IAVL trees of the auth (account)
+// and evm (storage) stores, queried through ABCI at the given height. They're not Ethereum MPT
+// proofs, so they have to be verified against the app hash instead of an Ethereum state root.
 func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
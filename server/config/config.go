@@ -51,6 +51,9 @@ const (
 
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultEVMGasCap is the default maximum gas cap for gas estimation (0=no limit)
+	DefaultEVMGasCap uint64 = 0
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
@@ -96,6 +99,12 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// GasCap defines the maximum gas cap accepted by eth_estimateGas, requested caps above it are clamped (0=no limit).
+	GasCap uint64 `mapstructure:"gas-cap"`
+	// StrictExtraEIPs rejects the params enabling extra EIPs already included in an active hard fork.
+	StrictExtraEIPs bool `mapstructure:"strict-extra-eips"`
+	// LegacyParamsQuery enables the query returning the params reconstructed from the legacy params subspace.
+	LegacyParamsQuery bool `mapstructure:"legacy-params-query"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -198,15 +207,20 @@ func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
 		Tracer:         DefaultEVMTracer,
 		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		GasCap:         DefaultEVMGasCap,
 	}
 }
 
-// Validate returns an error if the tracer type is invalid.
+// Validate returns an error if the tracer type or the gas cap are invalid.
 func (c EVMConfig) Validate() error {
 	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.GasCap != 0 && c.GasCap < 21000 {
+		return fmt.Errorf("EVM gas cap cannot be lower than 21,000, got %d", c.GasCap)
+	}
+
 	return nil
 }
 
@@ -330,8 +344,11 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:            v.GetString("evm.tracer"),
+			MaxTxGasWanted:    v.GetUint64("evm.max-tx-gas-wanted"),
+			GasCap:            v.GetUint64("evm.gas-cap"),
+			StrictExtraEIPs:   v.GetBool("evm.strict-extra-eips"),
+			LegacyParamsQuery: v.GetBool("evm.legacy-params-query"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -31,6 +31,18 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# GasCap sets the maximum gas cap accepted by eth_estimateGas, requested caps above it are
+# clamped to this value (0=no limit).
+gas-cap = {{ .EVM.GasCap }}
+
+# StrictExtraEIPs rejects the params updates enabling extra EIPs already included in a hard fork
+# active at the current height, instead of only logging a warning.
+strict-extra-eips = {{ .EVM.StrictExtraEIPs }}
+
+# LegacyParamsQuery serves the query returning the params reconstructed from the legacy x/params
+# subspace, to compare them with the migrated params while debugging.
+legacy-params-query = {{ .EVM.LegacyParamsQuery }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -73,8 +73,11 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer            = "evm.tracer"
+	EVMMaxTxGasWanted    = "evm.max-tx-gas-wanted"
+	EVMGasCap            = "evm.gas-cap"
+	EVMStrictExtraEIPs   = "evm.strict-extra-eips"
+	EVMLegacyParamsQuery = "evm.legacy-params-query"
 )
 
 // TLS flags
@@ -218,6 +218,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMGasCap, config.DefaultEVMGasCap, "the maximum gas cap accepted by eth_estimateGas, requested caps above it are clamped (0=no limit)")                     //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMStrictExtraEIPs, false, "reject the params updates enabling extra EIPs already included in an active hard fork, instead of only logging a warning")         //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMLegacyParamsQuery, false, "serve the query returning the evm params reconstructed from the legacy params subspace, for debugging")                          //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
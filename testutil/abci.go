@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"fmt"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -52,6 +53,36 @@ func Commit(ctx sdk.Context, app *app.EthermintApp, t time.Duration, vs *tmtypes
 	return ctx.WithBlockHeader(header), nil
 }
 
+// DeliverEthTxs delivers the given txs in a single block at the next height, without committing
+// it. It returns the results of all the txs, along with an error if any of them failed.
+func DeliverEthTxs(ctx sdk.Context, app *app.EthermintApp, txs ...sdk.Tx) ([]*abci.ExecTxResult, error) {
+	txsBytes := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz, err := app.TxConfig().TxEncoder()(tx)
+		if err != nil {
+			return nil, err
+		}
+		txsBytes[i] = bz
+	}
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:          app.LastBlockHeight() + 1,
+		Txs:             txsBytes,
+		ProposerAddress: ctx.BlockHeader().ProposerAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, txResult := range res.TxResults {
+		if !txResult.IsOK() {
+			return res.TxResults, fmt.Errorf("tx %d failed with code %d: %s", i, txResult.Code, txResult.Log)
+		}
+	}
+
+	return res.TxResults, nil
+}
+
 // applyValSetChanges takes in tmtypes.ValidatorSet and []abci.ValidatorUpdate and will return a new tmtypes.ValidatorSet which has the
 // provided validator updates applied to the provided validator set.
 func applyValSetChanges(valSet *tmtypes.ValidatorSet, valUpdates []abci.ValidatorUpdate) (*tmtypes.ValidatorSet, error) {
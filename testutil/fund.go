@@ -16,9 +16,15 @@
 package testutil
 
 import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/app"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -43,3 +49,12 @@ func FundModuleAccount(bankKeeper bankkeeper.Keeper, ctx sdk.Context, recipientM
 
 	return bankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, recipientMod, amounts)
 }
+
+// FundEVMAccount is a utility function that funds an EVM address with the given amount of the
+// EVM denom, so that the balance reported by the EVM keeper is exactly the given amount. This
+// should be used for testing purposes only!
+func FundEVMAccount(app *app.EthermintApp, ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	evmDenom := app.EvmKeeper.GetParams(ctx).EvmDenom
+	coins := sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(amount)))
+	return FundAccount(app.BankKeeper, ctx, addr.Bytes(), coins)
+}
@@ -0,0 +1,29 @@
+package testutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+)
+
+func TestFundEVMAccount(t *testing.T) {
+	ethermintApp := app.Setup(false, nil)
+	ctx := ethermintApp.BaseApp.NewContextLegacy(false, tmproto.Header{Height: 1, ChainID: app.ChainID})
+
+	addr := tests.GenerateAddress()
+	// an amount that isn't a multiple of any denomination unit
+	amount, ok := new(big.Int).SetString("1000000000000000001", 10)
+	require.True(t, ok)
+
+	require.NoError(t, testutil.FundEVMAccount(ethermintApp, ctx, addr, amount))
+	require.Equal(t, amount, ethermintApp.EvmKeeper.GetBalance(ctx, addr))
+
+	require.NoError(t, testutil.FundEVMAccount(ethermintApp, ctx, addr, big.NewInt(1)))
+	require.Equal(t, new(big.Int).Add(amount, big.NewInt(1)), ethermintApp.EvmKeeper.GetBalance(ctx, addr))
+}
@@ -18,6 +18,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Get the evm params",
 					Long:      "Get the evm parameter values.",
 				},
+				{
+					RpcMethod:      "ParamsAtHeight",
+					Use:            "params-at-height [height]",
+					Short:          "Get the evm params at a height",
+					Long:           "Get the evm parameter values in effect at the given block height.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
 				{
 					RpcMethod: "Account",
 					Skip:      true,
@@ -64,6 +71,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "ResetParams",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod: "EthereumTx",
 					Skip:      true,
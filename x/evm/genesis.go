@@ -18,6 +18,7 @@ package evm
 import (
 	"bytes"
 	"fmt"
+	"slices"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -76,10 +77,11 @@ func InitGenesis(
 		}
 
 		k.SetCode(ctx, codeHash.Bytes(), code)
-
-		for _, storage := range account.Storage {
-			k.SetState(ctx, address, common.HexToHash(storage.Key), common.HexToHash(storage.Value).Bytes())
+		if types.IsContractCodeHash(ethAcct.GetCodeHash()) {
+			k.SetContract(ctx, address)
 		}
+
+		k.SetStates(ctx, address, account.Storage)
 	}
 
 	return []abci.ValidatorUpdate{}
@@ -109,6 +111,12 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	// sort the accounts by address, so the exported genesis doesn't depend on the account store
+	// iteration order
+	slices.SortFunc(ethGenAccounts, func(a, b types.GenesisAccount) int {
+		return bytes.Compare(common.HexToAddress(a.Address).Bytes(), common.HexToAddress(b.Address).Bytes())
+	})
+
 	return &types.GenesisState{
 		Accounts: ethGenAccounts,
 		Params:   k.GetParams(ctx),
@@ -1,7 +1,9 @@
 package evm_test
 
 import (
+	"bytes"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -165,3 +167,34 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 		})
 	}
 }
+
+func (suite *EvmTestSuite) TestExportGenesisDeterministic() {
+	suite.SetupTest()
+
+	vmdb := suite.StateDB()
+	for i := 0; i < 5; i++ {
+		privkey, err := ethsecp256k1.GenerateKey()
+		suite.Require().NoError(err)
+		address := common.BytesToAddress(privkey.PubKey().Address())
+
+		vmdb.AddBalance(address, big.NewInt(1))
+		vmdb.SetCode(address, []byte{0x60, byte(i)})
+		vmdb.SetState(address, common.BigToHash(big.NewInt(int64(i))), common.BigToHash(big.NewInt(1)))
+	}
+	suite.Require().NoError(vmdb.Commit())
+
+	genState := evm.ExportGenesis(suite.ctx, suite.app.EvmKeeper, suite.app.AccountKeeper)
+	bz, err := suite.app.AppCodec().MarshalJSON(genState)
+	suite.Require().NoError(err)
+
+	genState2 := evm.ExportGenesis(suite.ctx, suite.app.EvmKeeper, suite.app.AccountKeeper)
+	bz2, err := suite.app.AppCodec().MarshalJSON(genState2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(bz, bz2)
+
+	suite.Require().True(sort.SliceIsSorted(genState.Accounts, func(i, j int) bool {
+		a := common.HexToAddress(genState.Accounts[i].Address)
+		b := common.HexToAddress(genState.Accounts[j].Address)
+		return bytes.Compare(a.Bytes(), b.Bytes()) < 0
+	}))
+}
@@ -203,6 +203,7 @@ func (suite *EvmTestSuite) TestHandleMsgEthereumTx() {
 		{
 			"passed",
 			func() {
+				suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.from, big.NewInt(100)))
 				to := common.BytesToAddress(suite.to)
 				tx = types.NewTx(suite.chainID, 0, &to, big.NewInt(100), 10_000_000, big.NewInt(10000), nil, nil, nil, nil)
 				suite.SignTx(tx)
@@ -409,6 +410,7 @@ func (suite *EvmTestSuite) TestSendTransaction() {
 	gasPrice := big.NewInt(0x55ae82600)
 
 	// send simple value transfer with gasLimit=21000
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.from, big.NewInt(1)))
 	tx := types.NewTx(suite.chainID, 1, &common.Address{0x1}, big.NewInt(1), gasLimit, gasPrice, nil, nil, nil, nil)
 	suite.SignTx(tx)
 
@@ -18,22 +18,30 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper. On the first block processed by
+// the node, it also warns about an inconsistent base fee configuration.
 func (k *Keeper) BeginBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	k.WithChainID(sdkCtx)
+	if !k.baseFeeConfigChecked {
+		k.baseFeeConfigChecked = true
+		k.WarnBaseFeeConfig(sdkCtx)
+	}
 	return nil
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore, and prunes the history of the block falling out of the retention window. The EVM end
+// block logic doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -41,7 +49,30 @@ func (k *Keeper) EndBlock(ctx context.Context) error {
 	infCtx := sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
+	if err := k.SetBlockBloom(infCtx, uint64(infCtx.BlockHeight()), bloom); err != nil {
+		return err
+	}
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	if height := uint64(infCtx.BlockHeight()); height > types.HistoryRetentionBlocks {
+		if err := k.pruneHistory(infCtx, height-types.HistoryRetentionBlocks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneHistory deletes the data stored for the ethereum txs of the given block height.
+func (k *Keeper) pruneHistory(ctx sdk.Context, height uint64) error {
+	if err := k.PruneLogs(ctx, height); err != nil {
+		return errorsmod.Wrapf(err, "failed to prune the logs of block %d", height)
+	}
+	if err := k.DeleteBlockBloom(ctx, height); err != nil {
+		return errorsmod.Wrapf(err, "failed to prune the bloom of block %d", height)
+	}
+	if err := k.PruneTxReceipts(ctx, height); err != nil {
+		return errorsmod.Wrapf(err, "failed to prune the tx receipts of block %d", height)
+	}
 	return nil
 }
@@ -1,6 +1,11 @@
 package keeper_test
 
 import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -15,3 +20,106 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	suite.Require().Equal(1, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
 }
+
+func (suite *KeeperTestSuite) TestEndBlockStoresBloom() {
+	suite.SetupTest()
+	height := uint64(suite.ctx.BlockHeight())
+
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, big.NewInt(10))
+
+	// the bloom is only stored at the end of the block
+	bloom, err := suite.app.EvmKeeper.GetBlockBloom(suite.ctx, height)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethtypes.Bloom{}, bloom)
+
+	err = suite.app.EvmKeeper.EndBlock(suite.ctx)
+	suite.Require().NoError(err)
+
+	bloom, err = suite.app.EvmKeeper.GetBlockBloom(suite.ctx, height)
+	suite.Require().NoError(err)
+
+	logs, err := suite.app.EvmKeeper.GetLogsByHeight(suite.ctx, height)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)), bloom)
+
+	transferTopic := evmtypes.ERC20Contract.ABI.Events["Transfer"].ID
+	suite.Require().True(ethtypes.BloomLookup(bloom, contractAddr))
+	suite.Require().True(ethtypes.BloomLookup(bloom, transferTopic))
+	suite.Require().True(ethtypes.BloomLookup(bloom, common.BytesToHash(recipient.Bytes())))
+
+	// blocks without logs have an empty bloom
+	bloom, err = suite.app.EvmKeeper.GetBlockBloom(suite.ctx, height+1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethtypes.Bloom{}, bloom)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockPrunesHistory() {
+	suite.SetupTest()
+	height := uint64(suite.ctx.BlockHeight())
+
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	txHash := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, big.NewInt(10)).AsTransaction().Hash()
+	info := evmtypes.CosmosTxInfo{CosmosTxHash: "ABCD", BlockHeight: height}
+	suite.Require().NoError(suite.app.EvmKeeper.SetCosmosTxInfo(suite.ctx, txHash, info))
+	suite.Require().NoError(suite.app.EvmKeeper.EndBlock(suite.ctx))
+
+	logs, err := suite.app.EvmKeeper.GetLogsByHeight(suite.ctx, height)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(logs)
+	blockHash := logs[0].BlockHash
+
+	// the block is still within the retention window
+	ctx := suite.ctx.WithBlockHeight(int64(height + evmtypes.HistoryRetentionBlocks - 1))
+	suite.Require().NoError(suite.app.EvmKeeper.EndBlock(ctx))
+
+	logs, err = suite.app.EvmKeeper.GetLogsByBlock(ctx, blockHash)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(logs)
+
+	bloom, err := suite.app.EvmKeeper.GetBlockBloom(ctx, height)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(ethtypes.Bloom{}, bloom)
+
+	_, found, err := suite.app.EvmKeeper.GetTxReceipt(ctx, txHash)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	_, found, err = suite.app.EvmKeeper.GetCosmosTxHash(ctx, txHash)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	_, found, err = suite.app.EvmKeeper.GetTx(ctx, txHash)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	// the block falls out of the retention window
+	ctx = suite.ctx.WithBlockHeight(int64(height + evmtypes.HistoryRetentionBlocks))
+	suite.Require().NoError(suite.app.EvmKeeper.EndBlock(ctx))
+
+	logs, err = suite.app.EvmKeeper.GetLogsByHeight(ctx, height)
+	suite.Require().NoError(err)
+	suite.Require().Empty(logs)
+
+	logs, err = suite.app.EvmKeeper.GetLogsByBlock(ctx, blockHash)
+	suite.Require().NoError(err)
+	suite.Require().Empty(logs)
+
+	bloom, err = suite.app.EvmKeeper.GetBlockBloom(ctx, height)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethtypes.Bloom{}, bloom)
+
+	_, found, err = suite.app.EvmKeeper.GetTxReceipt(ctx, txHash)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	_, found, err = suite.app.EvmKeeper.GetCosmosTxHash(ctx, txHash)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	_, found, err = suite.app.EvmKeeper.GetTx(ctx, txHash)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// AccessListResult is the EIP-2930 access list generated for a message call.
+type AccessListResult struct {
+	// AccessList contains the addresses and storage slots accessed by the call
+	AccessList ethtypes.AccessList
+	// GasUsed is the gas estimated for the call with the access list
+	GasUsed uint64
+	// GasUsedWithoutAccessList is the gas estimated for the call without any access list
+	GasUsedWithoutAccessList uint64
+	// VmError is the error of the execution with the access list, if it failed
+	VmError string
+}
+
+// CreateAccessList implements the `eth_createAccessList` rpc api: it executes the call the same way
+// as EthCall, recording the addresses and storage slots accessed, and returns them as an access
+// list along with the gas estimated with and without it. The sender, the recipient and the
+// precompiles are always warm, so they're left out of the list. As the access list changes the gas
+// costs, and thus possibly the execution path, the call is executed again with the generated list
+// until it's stable. The access list provided by the args, if any, is the starting point.
+func (k *Keeper) CreateAccessList(ctx sdk.Context, args types.TransactionArgs) (*AccessListResult, error) {
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, nil), k.ChainID())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	precompiles := vm.ActivePrecompiles(rules)
+	for addr := range k.customPrecompiles {
+		precompiles = append(precompiles, addr)
+	}
+
+	gasCap := k.gasCap
+	if gasCap == 0 {
+		gasCap = math.MaxUint64 / 2
+	}
+	// the gas used by a call is at least the min gas multiplier share of its gas limit, the gas
+	// requirement is estimated instead
+	estimateGas := func(accessList *ethtypes.AccessList) (uint64, error) {
+		args.AccessList = accessList
+		bz, err := json.Marshal(&args)
+		if err != nil {
+			return 0, err
+		}
+		res, err := k.EstimateGas(ctx, &types.EthCallRequest{Args: bz, GasCap: gasCap, ChainId: k.ChainID().Int64()})
+		if err != nil {
+			return 0, err
+		}
+		return res.Gas, nil
+	}
+
+	var prevList ethtypes.AccessList
+	if args.AccessList != nil {
+		prevList = *args.AccessList
+	}
+	gasWithoutList, err := estimateGas(nil)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to estimate the gas without access list")
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	prevTracer := logger.NewAccessListTracer(prevList, from, to, precompiles)
+	for {
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+		msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, err
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to apply the call with access list %v", accessList)
+		}
+		if !tracer.Equal(prevTracer) {
+			prevTracer = tracer
+			continue
+		}
+
+		gas, err := estimateGas(&accessList)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to estimate the gas with access list")
+		}
+		return &AccessListResult{
+			AccessList:               accessList,
+			GasUsed:                  gas,
+			GasUsedWithoutAccessList: gasWithoutList,
+			VmError:                  res.VmError,
+		}, nil
+	}
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	suite.SetupTest()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	recipient := tests.GenerateAddress()
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+	suite.Require().NoError(err)
+
+	args := types.TransactionArgs{
+		From: &suite.address,
+		To:   &contractAddr,
+		Data: (*hexutil.Bytes)(&transferData),
+	}
+	res, err := suite.app.EvmKeeper.CreateAccessList(suite.ctx, args)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+
+	// the balances are stored in the mapping at slot 0 of the contract
+	balanceSlot := func(addr common.Address) common.Hash {
+		return crypto.Keccak256Hash(common.LeftPadBytes(addr.Bytes(), 32), common.LeftPadBytes(nil, 32))
+	}
+	suite.Require().Len(res.AccessList, 1)
+	suite.Require().Equal(contractAddr, res.AccessList[0].Address)
+	suite.Require().Contains(res.AccessList[0].StorageKeys, balanceSlot(suite.address))
+	suite.Require().Contains(res.AccessList[0].StorageKeys, balanceSlot(recipient))
+
+	// the recipient is warm already, so its entry is charged without saving anything while each of its
+	// slots is cheaper than the cold access
+	slots := uint64(len(res.AccessList[0].StorageKeys))
+	slotSaving := ethparams.ColdSloadCostEIP2929 - ethparams.WarmStorageReadCostEIP2929 - ethparams.TxAccessListStorageKeyGas
+	suite.Require().Equal(res.GasUsedWithoutAccessList+ethparams.TxAccessListAddressGas-slots*slotSaving, res.GasUsed)
+
+	// the access list provided is used as a starting point
+	args.AccessList = &res.AccessList
+	res2, err := suite.app.EvmKeeper.CreateAccessList(suite.ctx, args)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.AccessList, res2.AccessList)
+	suite.Require().Equal(res.GasUsed, res2.GasUsed)
+}
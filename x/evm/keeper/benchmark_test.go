@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethermint "github.com/evmos/ethermint/types"
@@ -113,6 +115,27 @@ func BenchmarkTokenMint(b *testing.B) {
 	})
 }
 
+func BenchmarkEstimateGasTokenTransfer(b *testing.B) {
+	suite, contractAddr := SetupContract(b)
+
+	input, err := types.ERC20Contract.ABI.Pack("transfer", common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), big.NewInt(1000))
+	require.NoError(b, err)
+	args, err := json.Marshal(&types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&input)})
+	require.NoError(b, err)
+	req := &types.EthCallRequest{
+		Args:            args,
+		GasCap:          25_000_000,
+		ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+	}
+
+	b.ResetTimer()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := suite.app.EvmKeeper.EstimateGas(suite.ctx, req)
+		require.NoError(b, err)
+	}
+}
+
 func BenchmarkMessageCall(b *testing.B) {
 	suite, contract := SetupTestMessageCall(b)
 
@@ -143,3 +166,20 @@ func BenchmarkMessageCall(b *testing.B) {
 		require.False(b, rsp.Failed())
 	}
 }
+
+func BenchmarkGetEVMDenom(b *testing.B) {
+	suite := KeeperTestSuite{}
+	suite.SetupTestWithT(b)
+
+	b.Run("GetEVMDenom", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = suite.app.EvmKeeper.GetEVMDenom(suite.ctx)
+		}
+	})
+
+	b.Run("GetParams", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+		}
+	})
+}
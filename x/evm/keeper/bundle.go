@@ -0,0 +1,133 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// BundleTxResult is the result of a message simulated in a bundle.
+type BundleTxResult struct {
+	// Response of the execution, nil if the message couldn't be applied
+	Response *types.MsgEthereumTxResponse
+	// Err is the error preventing the message to be applied
+	Err error
+}
+
+// Failed returns true if the message couldn't be applied or if its execution failed.
+func (r BundleTxResult) Failed() bool {
+	return r.Err != nil || r.Response.Failed()
+}
+
+// BundleResult is the result of the simulation of a bundle of messages.
+type BundleResult struct {
+	// Results of the simulated messages in order, the simulation stops after the first failing
+	// message unless it continues on errors
+	Results []BundleTxResult
+	// StateDiff is the aggregated changes made to the accounts by the simulated messages
+	StateDiff statedb.StateOverride
+}
+
+// SimulateBundle executes the messages in order, each of them against the state left by the
+// previous ones, as if they were included in the current block. The senders are read from the
+// messages From field when set, the signatures are recovered otherwise. The simulation stops at the
+// first failing message unless continueOnError is set. Nothing is committed to the context's state,
+// the state changes are only reported in the result.
+func (k *Keeper) SimulateBundle(ctx sdk.Context, msgs []*types.MsgEthereumTx, continueOnError bool) (*BundleResult, error) {
+	// execute on a cached context so the simulated state is always discarded
+	ctx, _ = ctx.CacheContext()
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, nil), k.ChainID())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	result := &BundleResult{
+		Results:   make([]BundleTxResult, 0, len(msgs)),
+		StateDiff: make(statedb.StateOverride),
+	}
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	for i, msgEth := range msgs {
+		txConfig.TxHash = msgEth.AsTransaction().Hash()
+		txConfig.TxIndex = uint(i)
+
+		txResult, stateDiff := k.simulateBundleTx(ctx, msgEth, signer, cfg, txConfig)
+		result.Results = append(result.Results, txResult)
+		result.StateDiff.Merge(stateDiff)
+		if txResult.Response != nil {
+			txConfig.LogIndex += uint(len(txResult.Response.Logs))
+		}
+		if txResult.Failed() && !continueOnError {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// simulateBundleTx applies a message of a bundle and commits its state changes to the cached
+// context of the bundle, they're returned as well. The sender nonce is validated and incremented like
+// the ante handler does.
+func (k *Keeper) simulateBundleTx(
+	ctx sdk.Context,
+	msgEth *types.MsgEthereumTx,
+	signer ethtypes.Signer,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (BundleTxResult, statedb.StateOverride) {
+	if err := cfg.Params.CheckTxGasLimit(msgEth.GetGas()); err != nil {
+		return BundleTxResult{Err: err}, nil
+	}
+	msg, err := msgEth.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return BundleTxResult{Err: errorsmod.Wrap(err, "failed to return ethereum transaction as core message")}, nil
+	}
+	if err := k.checkSenderValue(ctx, msg); err != nil {
+		return BundleTxResult{Err: err}, nil
+	}
+	if nonce := k.GetNonce(ctx, msg.From()); msg.Nonce() != nonce {
+		return BundleTxResult{Err: errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected %d", msg.Nonce(), nonce,
+		)}, nil
+	}
+
+	stateDB := statedb.New(ctx, k, txConfig)
+	if msg.To() != nil {
+		// contract creations manage the nonce during the execution
+		stateDB.SetNonce(msg.From(), msg.Nonce()+1)
+	}
+	res, err := k.applyMessageWithStateDB(ctx, msg, nil, false, cfg, stateDB)
+	if err != nil {
+		return BundleTxResult{Err: errorsmod.Wrap(err, "failed to apply ethereum core message")}, nil
+	}
+	// the diff is computed against the committed state
+	stateDiff := stateDB.StateDiff()
+	if err := stateDB.Commit(); err != nil {
+		return BundleTxResult{Err: errorsmod.Wrap(err, "failed to commit stateDB")}, nil
+	}
+	return BundleTxResult{Response: res}, stateDiff
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestSimulateBundle() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	chainID := k.ChainID()
+
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, supply)
+	suite.Require().NoError(err)
+	nonce := k.GetNonce(suite.ctx, suite.address)
+	contractAddr := crypto.CreateAddress(suite.address, nonce)
+	recipient := tests.GenerateAddress()
+
+	newMsg := func(nonce uint64, to *common.Address, data []byte) *types.MsgEthereumTx {
+		msg := types.NewTx(chainID, nonce, to, big.NewInt(0), 2_000_000, big.NewInt(0), nil, nil, data, nil)
+		msg.From = suite.address.Hex()
+		return msg
+	}
+	transfer := func(nonce uint64, amount *big.Int) *types.MsgEthereumTx {
+		data, err := types.ERC20Contract.ABI.Pack("transfer", recipient, amount)
+		suite.Require().NoError(err)
+		return newMsg(nonce, &contractAddr, data)
+	}
+
+	// the call sees the contract deployed by the previous message
+	deploy := newMsg(nonce, nil, append(types.ERC20Contract.Bin, ctorArgs...))
+	res, err := k.SimulateBundle(suite.ctx, []*types.MsgEthereumTx{deploy, transfer(nonce+1, big.NewInt(1000))}, false)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 2)
+	for _, txResult := range res.Results {
+		suite.Require().NoError(txResult.Err)
+		suite.Require().False(txResult.Failed(), txResult.Response.VmError)
+	}
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)).Bytes(), res.Results[1].Response.Ret)
+	suite.Require().Len(res.Results[1].Response.Logs, 1)
+
+	contractDiff := res.StateDiff[contractAddr]
+	suite.Require().NotNil(contractDiff.Code)
+	suite.Require().NotNil(contractDiff.StateDiff)
+	suite.Require().Equal(uint64(nonce+2), uint64(*res.StateDiff[suite.address].Nonce))
+
+	// nothing is committed
+	suite.Require().Equal(nonce, k.GetNonce(suite.ctx, suite.address))
+	suite.Require().Nil(k.GetAccount(suite.ctx, contractAddr))
+
+	// the simulation stops at the first failing message
+	msgs := []*types.MsgEthereumTx{deploy, transfer(nonce+1, new(big.Int).Add(supply, big.NewInt(1))), transfer(nonce+2, big.NewInt(1000))}
+	res, err = k.SimulateBundle(suite.ctx, msgs, false)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 2)
+	suite.Require().True(res.Results[1].Failed())
+
+	res, err = k.SimulateBundle(suite.ctx, msgs, true)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 3)
+	suite.Require().True(res.Results[1].Failed())
+	suite.Require().False(res.Results[2].Failed(), res.Results[2].Response.VmError)
+	suite.Require().Equal(uint64(nonce+3), uint64(*res.StateDiff[suite.address].Nonce))
+
+	// a message with an invalid nonce isn't applied and doesn't consume the nonce
+	msgs = []*types.MsgEthereumTx{deploy, transfer(nonce+5, big.NewInt(1000)), transfer(nonce+1, big.NewInt(1000))}
+	res, err = k.SimulateBundle(suite.ctx, msgs, true)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 3)
+	suite.Require().ErrorIs(res.Results[1].Err, errortypes.ErrInvalidSequence)
+	suite.Require().Nil(res.Results[1].Response)
+	suite.Require().False(res.Results[2].Failed(), res.Results[2].Response.VmError)
+	suite.Require().Equal(uint64(nonce+2), uint64(*res.StateDiff[suite.address].Nonce))
+}
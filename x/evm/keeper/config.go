@@ -17,6 +17,7 @@ package keeper
 
 import (
 	"math/big"
+	"slices"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -70,10 +71,17 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 		debug = true
 	}
 
+	extraEIPs := cfg.Params.EIPs()
+	// go-ethereum doesn't switch to the Shanghai instruction set based on the fork block, so
+	// PUSH0 (EIP-3855) is enabled explicitly once the configured ShanghaiBlock is reached.
+	if cfg.ChainConfig.IsShanghai(big.NewInt(ctx.BlockHeight())) && !slices.Contains(extraEIPs, 3855) {
+		extraEIPs = append(extraEIPs, 3855)
+	}
+
 	return vm.Config{
 		Debug:     debug,
 		Tracer:    tracer,
 		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		ExtraEips: extraEIPs,
 	}
 }
@@ -30,7 +30,11 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -249,9 +253,15 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if cfg.Overrides, err = unmarshalStateOverride(req.Overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if cfg.BlockOverrides, err = unmarshalBlockOverrides(req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getCallNonce(ctx, args.GetFrom(), cfg.Overrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -270,6 +280,38 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// unmarshalStateOverride decodes the json encoded state overrides of a call request, if any.
+func unmarshalStateOverride(bz []byte) (statedb.StateOverride, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var overrides statedb.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// unmarshalBlockOverrides decodes the json encoded block overrides of a call request, if any.
+func unmarshalBlockOverrides(bz []byte) (*statedb.BlockOverrides, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var overrides statedb.BlockOverrides
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return nil, err
+	}
+	return &overrides, nil
+}
+
+// getCallNonce returns the nonce of the sender of a simulated call, taking its override into account.
+func (k Keeper) getCallNonce(ctx sdk.Context, from common.Address, overrides statedb.StateOverride) uint64 {
+	if nonce, ok := overrides.Nonce(from); ok {
+		return nonce
+	}
+	return k.GetNonce(ctx, from)
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	if req == nil {
@@ -286,6 +328,12 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.InvalidArgument, "gas cap cannot be lower than 21,000")
 	}
 
+	// Clamp the requested gas cap to the node's configured maximum, so clients can't force an
+	// expensive binary search by requesting an arbitrarily large cap.
+	if k.gasCap != 0 && req.GasCap > k.gasCap {
+		req.GasCap = k.gasCap
+	}
+
 	var args types.TransactionArgs
 	err = json.Unmarshal(req.Args, &args)
 	if err != nil {
@@ -323,9 +371,15 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	if cfg.Overrides, err = unmarshalStateOverride(req.Overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if cfg.BlockOverrides, err = unmarshalBlockOverrides(req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getCallNonce(ctx, args.GetFrom(), cfg.Overrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
@@ -339,8 +393,8 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
 	// so we don't wrap them with the gRPC status code
 
-	// Create a helper to check if a gas allowance results in an executable transaction
-	executable := func(gas uint64) (vmError bool, rsp *types.MsgEthereumTxResponse, err error) {
+	// Create a helper to execute the message with the given gas allowance
+	execute := func(gas uint64, tracer vm.EVMLogger) (vmError bool, rsp *types.MsgEthereumTxResponse, err error) {
 		// update the message with the new gas value
 		msg = ethtypes.NewMessage(
 			msg.From(),
@@ -357,7 +411,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		)
 
 		// pass false to not commit StateDB
-		rsp, err = k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
+		rsp, err = k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) {
 				return true, nil, nil // Special case, raise gas limit
@@ -367,33 +421,77 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return len(rsp.VmError) > 0, rsp, nil
 	}
 
-	// Execute the binary search and hone in on an executable gas limit
-	hi, err = types.BinSearch(lo, hi, executable)
+	// Create a helper to check if a gas allowance results in an executable transaction
+	executable := func(gas uint64) (vmError bool, rsp *types.MsgEthereumTxResponse, err error) {
+		return execute(gas, nil)
+	}
+
+	// Execute the message once at the highest allowance, rejecting the transaction as invalid
+	// if it fails, and recording the gas actually used to narrow down the binary search.
+	gasTracer := &gasUsedTracer{}
+	failed, result, err := execute(hi, gasTracer)
+	if err != nil {
+		return nil, err
+	}
+
+	if failed {
+		if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
+			if result.VmError == vm.ErrExecutionReverted.Error() {
+				return nil, types.NewExecErrorWithReason(result.Ret)
+			}
+			return nil, errors.New(result.VmError)
+		}
+		// Otherwise, the specified gas cap is too low
+		return nil, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
+	}
+
+	// NOTE: the gas used reported on the response is raised by the min gas multiplier, so the
+	// intrinsic gas plus the gas used by the top level call is used instead.
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, msg.To() == nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Reject the transaction as invalid if it still fails at the highest allowance
-	if hi == gasCap {
-		failed, result, err := executable(hi)
+	// The gas actually used is a lower bound of the gas requirement, which is usually very close
+	// to it, so try it first with a small buffer for the 63/64 rule and the call stipend.
+	gasUsed := intrinsicGas + gasTracer.gasUsed
+	if gasUsed > lo+1 {
+		lo = gasUsed - 1
+	}
+
+	if optimisticGas := (gasUsed + ethparams.CallStipend) * 64 / 63; optimisticGas < hi {
+		failed, _, err := executable(optimisticGas)
 		if err != nil {
 			return nil, err
 		}
 
 		if failed {
-			if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
-				if result.VmError == vm.ErrExecutionReverted.Error() {
-					return nil, types.NewExecErrorWithReason(result.Ret)
-				}
-				return nil, errors.New(result.VmError)
-			}
-			// Otherwise, the specified gas cap is too low
-			return nil, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
+			lo = optimisticGas
+		} else {
+			hi = optimisticGas
 		}
 	}
+
+	// Execute the binary search and hone in on an executable gas limit
+	hi, err = types.BinSearch(lo, hi, executable)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// gasUsedTracer records the gas used by the top level call of a message execution.
+type gasUsedTracer struct {
+	types.NoOpTracer
+	gasUsed uint64
+}
+
+// CaptureEnd implements vm.Tracer interface
+func (t *gasUsedTracer) CaptureEnd(_ []byte, gasUsed uint64, _ time.Duration, _ error) {
+	t.gasUsed = gasUsed
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -637,6 +735,180 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// Config implements the Query/Config gRPC method
+func (k Keeper) Config(c context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryConfigResponse{
+		MaxCallDepth: k.MaxCallDepth(),
+		MaxStackSize: k.MaxStackSize(),
+		MaxCodeSize:  k.MaxCodeSize(ctx),
+	}, nil
+}
+
+// EthChainID implements the Query/EthChainID gRPC method
+func (k Keeper) EthChainID(c context.Context, _ *types.QueryChainIDRequest) (*types.QueryChainIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryChainIDResponse{
+		ChainId: ctx.ChainID(),
+	}
+	if chainID := k.ChainID(); chainID != nil {
+		aux := sdkmath.NewIntFromBigInt(chainID)
+		res.Eip155ChainId = &aux
+	}
+
+	return res, nil
+}
+
+// TxReceipt implements the Query/TxReceipt gRPC method
+func (k Keeper) TxReceipt(c context.Context, req *types.QueryTxReceiptRequest) (*types.QueryTxReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receipt, found, err := k.GetTxReceipt(ctx, common.HexToHash(req.TxHash))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "receipt of tx %s not found", req.TxHash)
+	}
+
+	return &types.QueryTxReceiptResponse{
+		Receipt: receipt,
+	}, nil
+}
+
+// ContractCreation implements the Query/ContractCreation gRPC method
+func (k Keeper) ContractCreation(c context.Context, req *types.QueryContractCreationRequest) (*types.QueryContractCreationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	creation, found, err := k.GetContractCreation(ctx, common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "creation of contract %s not found", req.Address)
+	}
+
+	return &types.QueryContractCreationResponse{
+		Creation: creation,
+	}, nil
+}
+
+// AccountDump implements the Query/AccountDump gRPC method
+func (k Keeper) AccountDump(c context.Context, req *types.QueryAccountDumpRequest) (*types.QueryAccountDumpResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument, err.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dump, err := k.DumpAccount(ctx, common.HexToAddress(req.Address), req.StorageLimit)
+	switch {
+	case errors.Is(err, errortypes.ErrUnknownAddress):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountDumpResponse{
+		Dump: *dump,
+	}, nil
+}
+
+// LegacyParams implements the Query/LegacyParams gRPC method, it's only served when enabled on the
+// node.
+func (k Keeper) LegacyParams(c context.Context, _ *types.QueryLegacyParamsRequest) (*types.QueryLegacyParamsResponse, error) {
+	if !k.legacyParamsQuery {
+		return nil, status.Error(codes.Unavailable, "legacy params query is disabled on this node")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryLegacyParamsResponse{
+		Params: k.GetLegacyParams(ctx),
+	}, nil
+}
+
+// ParamsAtHeight implements the Query/ParamsAtHeight gRPC method, it returns NotFound for the heights
+// preceding the params changes history.
+func (k Keeper) ParamsAtHeight(c context.Context, req *types.QueryParamsAtHeightRequest) (*types.QueryParamsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, found, err := k.GetParamsAtHeight(ctx, req.Height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no params recorded at or before height %d", req.Height)
+	}
+
+	return &types.QueryParamsAtHeightResponse{Params: params}, nil
+}
+
+// PendingNonce implements the Query/PendingNonce gRPC method, the nonces of the transactions accepted
+// by the mempool of the node are taken into account.
+func (k Keeper) PendingNonce(c context.Context, req *types.QueryPendingNonceRequest) (*types.QueryPendingNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPendingNonceResponse{
+		Nonce: k.GetPendingNonce(ctx, common.HexToAddress(req.Address)),
+	}, nil
+}
+
+// Contracts implements the Query/Contracts gRPC method. The contracts are paginated in address order
+// over the contract index, so a page only reads the contracts it returns.
+func (k Keeper) Contracts(c context.Context, req *types.QueryContractsRequest) (*types.QueryContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	contractStore := prefix.NewStore(store, types.KeyPrefixContract)
+
+	var contracts []string
+	pageRes, err := query.Paginate(contractStore, req.Pagination, func(key, _ []byte) error {
+		contracts = append(contracts, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -17,6 +19,9 @@ import (
 	"github.com/evmos/ethermint/x/evm/statedb"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
@@ -405,6 +410,73 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryLegacyParams() {
+	defer suite.app.EvmKeeper.SetLegacyParamsQuery(false)
+
+	_, err := suite.queryClient.LegacyParams(suite.ctx, &types.QueryLegacyParamsRequest{})
+	suite.Require().Error(err)
+
+	legacyParams := types.DefaultParams()
+	mergeNetsplitBlock := sdkmath.NewInt(1000)
+	legacyParams.ChainConfig.MergeNetsplitBlock = &mergeNetsplitBlock
+	legacyParams.ChainConfig.ShanghaiBlock = nil
+	legacyParams.ChainConfig.CancunBlock = nil
+	suite.app.GetSubspace(types.ModuleName).SetParamSet(suite.ctx, &legacyParams)
+
+	suite.app.EvmKeeper.SetLegacyParamsQuery(true)
+	res, err := suite.queryClient.LegacyParams(suite.ctx, &types.QueryLegacyParamsRequest{})
+	suite.Require().NoError(err)
+	// only the fields registered in the legacy param set are reconstructed
+	suite.Require().Equal(legacyParams.EvmDenom, res.Params.EvmDenom)
+	suite.Require().Equal(legacyParams.ChainConfig, res.Params.ChainConfig)
+	suite.Require().Equal("1000", res.Params.ChainConfig.MergeNetsplitBlock.String())
+
+	// the migrated params are left untouched
+	suite.Require().Equal(types.DefaultParams(), suite.app.EvmKeeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueryParamsAtHeight() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	oldParams := k.GetParams(suite.ctx)
+	suite.Require().NoError(k.SetParams(suite.ctx, oldParams))
+	suite.Commit()
+
+	// the params changed at height 5 are in effect from then on
+	newParams := oldParams
+	newParams.EnableCall = false
+	newParams.ExtraEIPs = []int64{1344}
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+	suite.Require().NoError(k.SetParams(suite.ctx, newParams))
+	suite.ctx = suite.ctx.WithBlockHeight(7)
+
+	testCases := []struct {
+		height    uint64
+		expParams types.Params
+	}{
+		{4, oldParams},
+		{5, newParams},
+		{6, newParams},
+	}
+	for _, tc := range testCases {
+		res, err := k.ParamsAtHeight(suite.ctx, &types.QueryParamsAtHeightRequest{Height: tc.height})
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.expParams, res.Params, "height %d", tc.height)
+	}
+
+	// the params aren't known before the history starts, the genesis params are recorded at height 0
+	_, found, err := k.GetParamsAtHeight(suite.ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.ParamsHistoryKey(0))
+	_, found, err = k.GetParamsAtHeight(suite.ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+	_, err = k.ParamsAtHeight(suite.ctx, &types.QueryParamsAtHeightRequest{Height: 0})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	var (
 		req        *types.QueryValidatorAccountRequest
@@ -704,6 +776,32 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 			ethparams.TxGas,
 			false,
 		},
+		{
+			"request gasCap above the configured gas cap is clamped",
+			func() {
+				suite.app.EvmKeeper.SetGasCap(ethparams.TxGas)
+				args = types.TransactionArgs{To: &common.Address{}}
+			},
+			true,
+			ethparams.TxGas,
+			false,
+		},
+		{
+			"gas required exceeds the configured gas cap",
+			func() {
+				suite.app.EvmKeeper.SetGasCap(50_000)
+				ctorArgs, err := types.ERC20Contract.ABI.Pack("", &suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+				suite.Require().NoError(err)
+				data := append(types.ERC20Contract.Bin, ctorArgs...)
+				args = types.TransactionArgs{
+					From: &suite.address,
+					Data: (*hexutil.Bytes)(&data),
+				}
+			},
+			false,
+			0,
+			false,
+		},
 		{
 			"invalid args - specified both gasPrice and maxFeePerGas",
 			func() {
@@ -746,6 +844,39 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestEstimateGasTightBound() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), big.NewInt(1000))
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&transferData)})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EstimateGas(suite.ctx, &types.EthCallRequest{
+		Args:            args,
+		GasCap:          uint64(config.DefaultGasCap),
+		ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+	})
+	suite.Require().NoError(err)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := types.NewTx(chainID, nonce, &contractAddr, nil, res.Gas, nil, nil, nil, transferData, nil)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	rsp, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+
+	// the estimate covers the gas used, and is within the 63/64 rule and call stipend buffer of it
+	suite.Require().GreaterOrEqual(res.Gas, rsp.GasUsed)
+	suite.Require().LessOrEqual(res.Gas, (rsp.GasUsed+ethparams.CallStipend)*64/63)
+}
+
 func (suite *KeeperTestSuite) TestTraceTx() {
 	// TODO deploy contract that triggers internal transactions
 	var (
@@ -1326,6 +1457,181 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallStateOverrides() {
+	suite.SetupTest()
+
+	sender := tests.GenerateAddress()
+	recipient := tests.GenerateAddress()
+	value := hexutil.Big(*big.NewInt(1000))
+	transferArgs, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &recipient, Value: &value})
+	suite.Require().NoError(err)
+
+	// the sender can't cover the value
+	res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: transferArgs, GasCap: config.DefaultGasCap})
+	suite.Require().True(err != nil || res.Failed())
+	_, err = suite.queryClient.EstimateGas(suite.ctx, &types.EthCallRequest{Args: transferArgs, GasCap: config.DefaultGasCap})
+	suite.Require().Error(err)
+
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	nonce := hexutil.Uint64(5)
+	overrides, err := json.Marshal(statedb.StateOverride{
+		sender: {Balance: &balance, Nonce: &nonce},
+	})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: transferArgs, GasCap: config.DefaultGasCap, Overrides: overrides})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	gas, err := suite.queryClient.EstimateGas(suite.ctx, &types.EthCallRequest{Args: transferArgs, GasCap: config.DefaultGasCap, Overrides: overrides})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethparams.TxGas, gas.Gas)
+
+	// the overrides aren't persisted
+	suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(suite.ctx, sender).Int64())
+	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, sender))
+	suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(suite.ctx, recipient).Int64())
+
+	// code and storage: SLOAD(0) MSTORE(0) RETURN(0, 32)
+	contract := tests.GenerateAddress()
+	code := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+
+	// the overridden code isn't deployed by the call, the max code size doesn't apply to it
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.MaxCodeSize = uint64(len(code)) - 1
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+	callArgs, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+	suite.Require().NoError(err)
+
+	stateDiff := map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(42))}
+	overrides, err = json.Marshal(statedb.StateOverride{
+		contract: {Code: &code, StateDiff: &stateDiff},
+	})
+	suite.Require().NoError(err)
+	res, err = suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: callArgs, GasCap: config.DefaultGasCap, Overrides: overrides})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(42)).Bytes(), res.Ret)
+	suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(crypto.Keccak256(code))))
+
+	// state and stateDiff can't be combined
+	overrides, err = json.Marshal(statedb.StateOverride{
+		contract: {Code: &code, State: &stateDiff, StateDiff: &stateDiff},
+	})
+	suite.Require().NoError(err)
+	_, err = suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: callArgs, GasCap: config.DefaultGasCap, Overrides: overrides})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestEthCallBlockOverrides() {
+	suite.SetupTest()
+
+	sender := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	// TIMESTAMP MSTORE(0) RETURN(0, 32)
+	code := hexutil.Bytes(common.FromHex("0x4260005260206000f3"))
+	overrides, err := json.Marshal(statedb.StateOverride{contract: {Code: &code}})
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(suite.ctx.BlockTime().Unix())).Bytes(), res.Ret)
+
+	timestamp := hexutil.Uint64(4102444800)
+	number := (*hexutil.Big)(big.NewInt(1000))
+	blockOverrides, err := json.Marshal(statedb.BlockOverrides{Time: &timestamp, Number: number})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{
+		Args:           args,
+		GasCap:         config.DefaultGasCap,
+		Overrides:      overrides,
+		BlockOverrides: blockOverrides,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(new(big.Int).SetUint64(uint64(timestamp))).Bytes(), res.Ret)
+
+	// the committed block context is left unchanged
+	suite.Require().NotEqual(int64(timestamp), suite.ctx.BlockTime().Unix())
+
+	_, err = suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, BlockOverrides: []byte("{")})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryContracts() {
+	suite.SetupTest()
+
+	contracts := []common.Address{
+		suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100)),
+		suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100)),
+	}
+	for i := 0; i < 3; i++ {
+		suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, tests.GenerateAddress(), big.NewInt(100)))
+	}
+	// an account without a code hash isn't a contract
+	blankAcct, ok := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, tests.GenerateAddress().Bytes()).(*ethermint.EthAccount)
+	suite.Require().True(ok)
+	blankAcct.CodeHash = ""
+	suite.app.AccountKeeper.SetAccount(suite.ctx, blankAcct)
+
+	// contracts are returned in address order
+	if bytes.Compare(contracts[0].Bytes(), contracts[1].Bytes()) > 0 {
+		contracts[0], contracts[1] = contracts[1], contracts[0]
+	}
+
+	res, err := suite.queryClient.Contracts(suite.ctx, &types.QueryContractsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contracts[0].Hex(), contracts[1].Hex()}, res.Contracts)
+	suite.Require().Empty(res.Pagination.NextKey)
+
+	// one contract per page
+	res, err = suite.queryClient.Contracts(suite.ctx, &types.QueryContractsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contracts[0].Hex()}, res.Contracts)
+	suite.Require().Equal(contracts[1].Bytes(), res.Pagination.NextKey)
+
+	res, err = suite.queryClient.Contracts(suite.ctx, &types.QueryContractsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contracts[1].Hex()}, res.Contracts)
+	suite.Require().Empty(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.Contracts(suite.ctx, &types.QueryContractsRequest{
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contracts[1].Hex()}, res.Contracts)
+
+	_, err = suite.queryClient.Contracts(suite.ctx, &types.QueryContractsRequest{
+		Pagination: &query.PageRequest{Key: contracts[1].Bytes(), Offset: 1},
+	})
+	suite.Require().Error(err)
+
+	// deleted contracts are no longer listed
+	suite.Require().NoError(suite.app.EvmKeeper.DeleteAccount(suite.ctx, contracts[0]))
+	res, err = suite.queryClient.Contracts(suite.ctx, &types.QueryContractsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contracts[1].Hex()}, res.Contracts)
+
+	// nor are the disabled precompiles
+	precompileAddr := common.HexToAddress("0x0000000000000000000000000000000000000100")
+	suite.Require().NoError(suite.app.EvmKeeper.EnsurePrecompileAccount(suite.ctx, precompileAddr))
+	res, err = suite.queryClient.Contracts(suite.ctx, &types.QueryContractsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{precompileAddr.Hex(), contracts[1].Hex()}, res.Contracts)
+
+	suite.Require().NoError(suite.app.EvmKeeper.ResetPrecompileAccount(suite.ctx, precompileAddr))
+	res, err = suite.queryClient.Contracts(suite.ctx, &types.QueryContractsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contracts[1].Hex()}, res.Contracts)
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1393,6 +1699,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			"Contracts method",
+			func() (interface{}, error) {
+				return k.Contracts(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1403,3 +1715,150 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryConfig() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.Config(suite.ctx, &types.QueryConfigRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1024), res.MaxCallDepth)
+	suite.Require().Equal(uint64(1024), res.MaxStackSize)
+	suite.Require().Equal(types.DefaultMaxCodeSize, res.MaxCodeSize)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.MaxCodeSize = 1024
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	res, err = suite.queryClient.Config(suite.ctx, &types.QueryConfigRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1024), res.MaxCodeSize)
+}
+
+func (suite *KeeperTestSuite) TestQueryEthChainID() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.EthChainID(suite.ctx, &types.QueryChainIDRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal("ethermint_9000-1", res.ChainId)
+	suite.Require().NotNil(res.Eip155ChainId)
+	suite.Require().Equal(suite.app.EvmKeeper.ChainID(), res.Eip155ChainId.BigInt())
+	suite.Require().Equal(int64(9000), res.Eip155ChainId.Int64())
+}
+
+func (suite *KeeperTestSuite) TestQueryTxReceipt() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+	suite.Require().NoError(err)
+	data := append(types.ERC20Contract.Bin, ctorArgs...)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := types.NewTxContract(chainID, nonce, nil, 2_000_000, nil, nil, nil, data, nil)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+	res, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+
+	rsp, err := suite.queryClient.TxReceipt(suite.ctx, &types.QueryTxReceiptRequest{TxHash: res.Hash})
+	suite.Require().NoError(err)
+	receipt := rsp.Receipt
+	suite.Require().Equal(res.Hash, receipt.TxHash)
+	suite.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+	suite.Require().Equal(res.GasUsed, receipt.GasUsed)
+	suite.Require().Equal(uint64(suite.ctx.BlockHeight()), receipt.BlockNumber)
+	suite.Require().Equal(crypto.CreateAddress(suite.address, nonce).Hex(), receipt.ContractAddress)
+	suite.Require().Equal(res.Logs, receipt.Logs)
+
+	// unknown tx
+	_, err = suite.queryClient.TxReceipt(suite.ctx, &types.QueryTxReceiptRequest{TxHash: common.Hash{}.Hex()})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryContractCreation() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	res, err := suite.queryClient.ContractCreation(suite.ctx, &types.QueryContractCreationRequest{Address: contractAddr.Hex()})
+	suite.Require().NoError(err)
+	creation := res.Creation
+	suite.Require().Equal(suite.address.Hex(), creation.Creator)
+	suite.Require().Equal(uint64(suite.ctx.BlockHeight()), creation.BlockHeight)
+	receipt, found, err := suite.app.EvmKeeper.GetTxReceipt(suite.ctx, common.HexToHash(creation.TxHash))
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(contractAddr.Hex(), receipt.ContractAddress)
+
+	// the init code deploys a child contract with CREATE2, PUSH5 <child init code> PUSH1 0 MSTORE
+	// CREATE2(value: 0, offset: 27, size: 5, salt: 0), the child init code returns a single byte of code
+	childInit := common.FromHex("0x60016000f3")
+	data := common.FromHex("0x6460016000f360005260006005601b6000f500")
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := types.NewTxContract(chainID, nonce, nil, 2_000_000, nil, nil, nil, data, nil)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+	txRes, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().False(txRes.Failed())
+
+	factory := crypto.CreateAddress(suite.address, nonce)
+	child := crypto.CreateAddress2(factory, common.Hash{}, crypto.Keccak256(childInit))
+	suite.Require().True(suite.app.EvmKeeper.GetAccount(suite.ctx, child).IsContract())
+	res, err = suite.queryClient.ContractCreation(suite.ctx, &types.QueryContractCreationRequest{Address: child.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.address.Hex(), res.Creation.Creator)
+	suite.Require().Equal(txRes.Hash, res.Creation.TxHash)
+
+	// the factory has no code
+	_, err = suite.queryClient.ContractCreation(suite.ctx, &types.QueryContractCreationRequest{Address: factory.Hex()})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = suite.queryClient.ContractCreation(suite.ctx, &types.QueryContractCreationRequest{Address: "0xinvalid"})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestQueryAccountDump() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	acct := suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr)
+	storage := suite.app.EvmKeeper.GetAccountStorage(suite.ctx, contractAddr)
+	suite.Require().Greater(len(storage), 1)
+
+	res, err := suite.queryClient.AccountDump(suite.ctx, &types.QueryAccountDumpRequest{Address: contractAddr.Hex()})
+	suite.Require().NoError(err)
+	dump := res.Dump
+	suite.Require().Equal(contractAddr.Hex(), dump.Address)
+	suite.Require().Equal(suite.app.EvmKeeper.GetBalance(suite.ctx, contractAddr).String(), dump.Balance)
+	suite.Require().Equal(suite.app.EvmKeeper.GetNonce(suite.ctx, contractAddr), dump.Nonce)
+	suite.Require().Equal(common.BytesToHash(acct.CodeHash).Hex(), dump.CodeHash)
+	suite.Require().Equal(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acct.CodeHash)), dump.Code)
+	suite.Require().Equal(crypto.Keccak256Hash(dump.Code).Hex(), dump.CodeHash)
+	suite.Require().Equal(storage, dump.Storage)
+	suite.Require().False(dump.StorageTruncated)
+
+	// the storage is bounded by the limit
+	res, err = suite.queryClient.AccountDump(suite.ctx, &types.QueryAccountDumpRequest{Address: contractAddr.Hex(), StorageLimit: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(storage[:1], res.Dump.Storage)
+	suite.Require().True(res.Dump.StorageTruncated)
+
+	// an account without code nor storage
+	res, err = suite.queryClient.AccountDump(suite.ctx, &types.QueryAccountDumpRequest{Address: suite.address.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Dump.Code)
+	suite.Require().Empty(res.Dump.Storage)
+	suite.Require().Equal(suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address), res.Dump.Nonce)
+
+	// unknown account
+	_, err = suite.queryClient.AccountDump(suite.ctx, &types.QueryAccountDumpRequest{Address: common.BigToAddress(big.NewInt(0xdead)).Hex()})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	// a contract whose code is missing from the store
+	brokenAddr := tests.GenerateAddress()
+	brokenAcct := statedb.Account{Nonce: 1, Balance: new(big.Int), CodeHash: crypto.Keccak256([]byte("missing code"))}
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, brokenAddr, brokenAcct))
+	_, err = suite.queryClient.AccountDump(suite.ctx, &types.QueryAccountDumpRequest{Address: brokenAddr.Hex()})
+	suite.Require().Equal(codes.Internal, status.Code(err))
+}
@@ -31,6 +31,31 @@ func (dh FailureHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receip
 	return errors.New("post tx processing failed")
 }
 
+// ContractRecordHook records the contracts deployed
+type ContractRecordHook struct {
+	Contracts []common.Address
+}
+
+func (dh *ContractRecordHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	if msg.To() == nil {
+		dh.Contracts = append(dh.Contracts, receipt.ContractAddress)
+	}
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksContractDeployment() {
+	suite.SetupTest()
+	hook := &ContractRecordHook{}
+	suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(hook))
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Require().Equal([]common.Address{contractAddr}, hook.Contracts)
+
+	// calls don't notify deployments
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), big.NewInt(10))
+	suite.Require().Equal([]common.Address{contractAddr}, hook.Contracts)
+}
+
 func (suite *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -25,6 +25,8 @@ import (
 	"github.com/evmos/ethermint/x/feemarket/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	tmtypes "github.com/cometbft/cometbft/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -125,6 +127,113 @@ var _ = Describe("Feemarket", func() {
 					}),
 				)
 			})
+
+			Context("during FinalizeEthBlock with multiple transactions", func() {
+				It("should accept transactions with consecutive nonces in the same block", func() {
+					to := tests.GenerateAddress()
+					first := buildEthTx(privKey, &to, 100000, big.NewInt(baseFee), nil, nil, nil)
+					second := evmtypes.NewTx(
+						s.app.EvmKeeper.ChainID(), first.AsTransaction().Nonce()+1, &to, nil, 100000,
+						big.NewInt(baseFee), nil, nil, nil, nil,
+					)
+					second.From = first.From
+
+					results, err := testutil.DeliverEthTxs(
+						s.ctx, s.app,
+						buildEthCosmosTx(privKey, first),
+						buildEthCosmosTx(privKey, second),
+					)
+					Expect(err).To(BeNil())
+					Expect(results).To(HaveLen(2))
+				})
+
+				It("should map the ethereum txs to the cosmos txs wrapping them", func() {
+					to := tests.GenerateAddress()
+					first := buildEthTx(privKey, &to, 100000, big.NewInt(baseFee), nil, nil, nil)
+					second := evmtypes.NewTx(
+						s.app.EvmKeeper.ChainID(), first.AsTransaction().Nonce()+1, &to, nil, 100000,
+						big.NewInt(baseFee), nil, nil, nil, nil,
+					)
+					second.From = first.From
+
+					txs := []sdk.Tx{buildEthCosmosTx(privKey, first), buildEthCosmosTx(privKey, second)}
+					_, err := testutil.DeliverEthTxs(s.ctx, s.app, txs...)
+					Expect(err).To(BeNil())
+					_, err = s.app.Commit()
+					Expect(err).To(BeNil())
+
+					ctx := s.app.NewContext(true)
+					for i, msg := range []*evmtypes.MsgEthereumTx{first, second} {
+						bz, err := s.app.TxConfig().TxEncoder()(txs[i])
+						Expect(err).To(BeNil())
+
+						info, found, err := s.app.EvmKeeper.GetCosmosTxHash(ctx, msg.AsTransaction().Hash())
+						Expect(err).To(BeNil())
+						Expect(found).To(BeTrue())
+						Expect(info.CosmosTxHash).To(Equal(tmbytes.HexBytes(tmtypes.Tx(bz).Hash()).String()))
+						Expect(info.BlockHeight).To(Equal(uint64(s.app.LastBlockHeight())))
+						Expect(info.TxIndex).To(Equal(uint64(i)))
+					}
+				})
+
+				It("should return all the results when a transaction fails", func() {
+					to := tests.GenerateAddress()
+					first := buildEthTx(privKey, &to, 100000, big.NewInt(baseFee), nil, nil, nil)
+					// reuses the nonce of the first tx
+					second := buildEthTx(privKey, &to, 100000, big.NewInt(baseFee), nil, nil, nil)
+
+					results, err := testutil.DeliverEthTxs(
+						s.ctx, s.app,
+						buildEthCosmosTx(privKey, first),
+						buildEthCosmosTx(privKey, second),
+					)
+					Expect(err).ToNot(BeNil())
+					Expect(results).To(HaveLen(2))
+					Expect(results[0].IsOK()).To(Equal(true), results[0].GetLog())
+					Expect(results[1].IsOK()).To(Equal(false))
+				})
+			})
+
+			Context("during CheckTx with multiple transactions", func() {
+				It("should serve the pending nonce through the gRPC query", func() {
+					from := common.BytesToAddress(privKey.PubKey().Address().Bytes())
+					nonce := getNonce(from.Bytes())
+					queryPendingNonce := func() uint64 {
+						bz, err := s.app.AppCodec().Marshal(&evmtypes.QueryPendingNonceRequest{Address: from.Hex()})
+						Expect(err).To(BeNil())
+						// the query is routed through the GRPCQueryRouter, on a check-tx context
+						res, err := s.app.Query(s.ctx, &abci.RequestQuery{
+							Path: "/ethermint.evm.v1.Query/PendingNonce",
+							Data: bz,
+						})
+						Expect(err).To(BeNil())
+						Expect(res.IsOK()).To(Equal(true), res.GetLog())
+
+						var resp evmtypes.QueryPendingNonceResponse
+						Expect(s.app.AppCodec().Unmarshal(res.Value, &resp)).To(BeNil())
+						return resp.Nonce
+					}
+					Expect(queryPendingNonce()).To(Equal(nonce))
+
+					to := tests.GenerateAddress()
+					for i := uint64(0); i < 2; i++ {
+						msg := evmtypes.NewTx(
+							s.app.EvmKeeper.ChainID(), nonce+i, &to, nil, 100000,
+							big.NewInt(baseFee), nil, nil, nil, nil,
+						)
+						msg.From = from.String()
+						res := checkEthTx(privKey, msg)
+						Expect(res.IsOK()).To(Equal(true), "transaction should have succeeded", res.GetLog())
+
+						Expect(queryPendingNonce()).To(Equal(nonce + i + 1))
+						Expect(getNonce(from.Bytes())).To(Equal(nonce))
+					}
+
+					// the txs weren't included, nothing is pending anymore once the block is committed
+					s.Commit()
+					Expect(queryPendingNonce()).To(Equal(nonce))
+				})
+			})
 		})
 	})
 })
@@ -249,6 +358,16 @@ func buildEthTx(
 
 func prepareEthTx(priv *ethsecp256k1.PrivKey, msgEthereumTx *evmtypes.MsgEthereumTx) []byte {
 	encodingConfig := encoding.MakeTestEncodingConfig(evm.AppModuleBasic{})
+
+	// bz are bytes to be broadcasted over the network
+	bz, err := encodingConfig.TxConfig.TxEncoder()(buildEthCosmosTx(priv, msgEthereumTx))
+	s.Require().NoError(err)
+
+	return bz
+}
+
+func buildEthCosmosTx(priv *ethsecp256k1.PrivKey, msgEthereumTx *evmtypes.MsgEthereumTx) sdk.Tx {
+	encodingConfig := encoding.MakeTestEncodingConfig(evm.AppModuleBasic{})
 	option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
 	s.Require().NoError(err)
 
@@ -273,11 +392,7 @@ func prepareEthTx(priv *ethsecp256k1.PrivKey, msgEthereumTx *evmtypes.MsgEthereu
 	builder.SetFeeAmount(fees)
 	builder.SetGasLimit(msgEthereumTx.GetGas())
 
-	// bz are bytes to be broadcasted over the network
-	bz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
-	s.Require().NoError(err)
-
-	return bz
+	return txBuilder.GetTx()
 }
 
 func checkEthTx(priv *ethsecp256k1.PrivKey, msgEthereumTx *evmtypes.MsgEthereumTx) *abci.ResponseCheckTx {
@@ -16,6 +16,9 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+	"math"
 	"math/big"
 
 	"cosmossdk.io/core/store"
@@ -24,13 +27,17 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 
 	ethermint "github.com/evmos/ethermint/types"
@@ -39,6 +46,8 @@ import (
 	evm "github.com/evmos/ethermint/x/evm/vm"
 )
 
+var precompileCodeHash = crypto.Keccak256(types.PrecompileCode)
+
 // Keeper grants access to the EVM module state and implements the go-ethereum StateDB interface.
 type Keeper struct {
 	// Protobuf codec
@@ -70,6 +79,29 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
+	// maximum gas cap accepted by EstimateGas, 0 means no limit
+	gasCap uint64
+
+	// reject the params enabling extra EIPs already included in an active hard fork, instead of
+	// only logging a warning
+	strictExtraEIPs bool
+
+	// the base fee configuration is checked on the first block processed by the node
+	baseFeeConfigChecked bool
+
+	// serve the LegacyParams query, meant for debugging the params migration
+	legacyParamsQuery bool
+
+	// next nonces of the senders of the transactions accepted by the mempool, used to serve the
+	// pending nonces
+	pendingNonces *pendingNonces
+
+	// committed multi store, queried for the merkle proofs of GetProof
+	proofQuerier storetypes.Queryable
+
+	// committed multi store, branched at past versions to replay the transactions with ReplayTx
+	historicalStore storetypes.MultiStore
+
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
 
@@ -121,6 +153,7 @@ func NewKeeper(
 		evmConstructor:    evmConstructor,
 		tracer:            tracer,
 		ss:                ss,
+		pendingNonces:     newPendingNonces(),
 	}
 }
 
@@ -129,7 +162,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
-// WithChainID sets the chain id to the local variable in the keeper
+// WithChainID sets the chain id to the local variable in the keeper. It can be called again with
+// the same chain id, but panics if the context chain id conflicts with the one already set.
 func (k *Keeper) WithChainID(ctx sdk.Context) {
 	chainID, err := ethermint.ParseChainID(ctx.ChainID())
 	if err != nil {
@@ -137,7 +171,7 @@ func (k *Keeper) WithChainID(ctx sdk.Context) {
 	}
 
 	if k.eip155ChainID != nil && k.eip155ChainID.Cmp(chainID) != 0 {
-		panic("chain id already set")
+		panic(fmt.Sprintf("chain id already set to %s, got conflicting chain id %s (%s)", k.eip155ChainID, chainID, ctx.ChainID()))
 	}
 
 	k.eip155ChainID = chainID
@@ -148,6 +182,25 @@ func (k Keeper) ChainID() *big.Int {
 	return k.eip155ChainID
 }
 
+// MaxCallDepth returns the maximum depth of nested calls and contract creations, enforced by the EVM.
+func (k Keeper) MaxCallDepth() uint64 {
+	return params.CallCreateDepth
+}
+
+// MaxStackSize returns the maximum number of items on the EVM stack.
+func (k Keeper) MaxStackSize() uint64 {
+	return params.StackLimit
+}
+
+// MaxCodeSize returns the effective maximum size of the code of a deployed contract: the limit set
+// in params, or the EIP-170 one if it isn't set.
+func (k Keeper) MaxCodeSize(ctx sdk.Context) uint64 {
+	if maxCodeSize := k.GetParams(ctx).MaxCodeSize; maxCodeSize > 0 {
+		return maxCodeSize
+	}
+	return params.MaxCodeSize
+}
+
 // ----------------------------------------------------------------------------
 // Block Bloom
 // Required by Web3 API.
@@ -163,6 +216,36 @@ func (k Keeper) EmitBlockBloomEvent(ctx sdk.Context, bloom ethtypes.Bloom) {
 	)
 }
 
+// SetBlockBloom stores the bloom filter aggregating the logs of all the txs of a given block height.
+// Blocks without logs have an empty bloom, which isn't stored. The stored bloom is the one FilterLogs
+// checks for every committed height, and it is kept for types.HistoryRetentionBlocks blocks.
+func (k Keeper) SetBlockBloom(ctx sdk.Context, height uint64, bloom ethtypes.Bloom) error {
+	if bloom == (ethtypes.Bloom{}) {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.BlockBloomKey(height), bloom.Bytes())
+}
+
+// GetBlockBloom returns the bloom filter of the given block height. An empty bloom is returned for
+// blocks without logs.
+func (k Keeper) GetBlockBloom(ctx sdk.Context, height uint64) (ethtypes.Bloom, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.BlockBloomKey(height))
+	if err != nil {
+		return ethtypes.Bloom{}, err
+	}
+
+	return ethtypes.BytesToBloom(bz), nil
+}
+
+// DeleteBlockBloom deletes the bloom filter of the given block height.
+func (k Keeper) DeleteBlockBloom(ctx sdk.Context, height uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.BlockBloomKey(height))
+}
+
 // GetAuthority returns the x/evm module authority address
 func (k Keeper) GetAuthority() sdk.AccAddress {
 	return k.authority
@@ -209,6 +292,28 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetDestructedAccountTransient records that the account was self-destructed by the transaction
+// being processed.
+func (k Keeper) SetDestructedAccountTransient(ctx sdk.Context, addr common.Address) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TransientDestructedKey(k.GetTxIndexTransient(ctx), addr), []byte{1})
+}
+
+// GetDestructedAccounts returns the accounts self-destructed by the transaction being processed,
+// ordered by address. The accounts are already deleted once the transaction state is committed.
+func (k Keeper) GetDestructedAccounts(ctx sdk.Context) []common.Address {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.TransientDestructedPrefix(k.GetTxIndexTransient(ctx)))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addrs []common.Address
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, common.BytesToAddress(iterator.Key()))
+	}
+	return addrs
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -247,10 +352,148 @@ func (k Keeper) GetAccountStorage(ctx sdk.Context, address common.Address) types
 	return storage
 }
 
+// GetStorageRoot returns the keccak256 hash of the account's storage slots, each encoded as its key
+// followed by its value, in ascending key order. This is a cosmos specific commitment meant for
+// consistency checks between nodes, not the root of an Ethereum storage trie. An account without
+// storage has the hash of the empty input.
+func (k Keeper) GetStorageRoot(ctx sdk.Context, addr common.Address) common.Hash {
+	hasher := crypto.NewKeccakState()
+	k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+		hasher.Write(key.Bytes())
+		hasher.Write(value.Bytes())
+		return true
+	})
+
+	var root common.Hash
+	_, _ = hasher.Read(root[:])
+	return root
+}
+
+// DumpAccount returns the balance, nonce, code and storage of the account at the given address. At
+// most storageLimit storage slots are returned, zero meaning no limit, and the dump is flagged as
+// truncated when the account holds more. It fails with ErrUnknownAddress if no account exists at the
+// address, and with ErrInvalidState if the code of a contract is missing from the store.
+func (k *Keeper) DumpAccount(ctx sdk.Context, addr common.Address, storageLimit uint64) (*types.AccountDump, error) {
+	acct := k.GetAccount(ctx, addr)
+	if acct == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", addr.Hex())
+	}
+
+	dump := &types.AccountDump{
+		Address:  addr.Hex(),
+		Balance:  acct.Balance.String(),
+		Nonce:    acct.Nonce,
+		CodeHash: common.BytesToHash(acct.CodeHash).Hex(),
+		Storage:  types.Storage{},
+	}
+	if acct.IsContract() {
+		codeHash := common.BytesToHash(acct.CodeHash)
+		dump.Code = k.GetCode(ctx, codeHash)
+		if len(dump.Code) == 0 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidState, "code %s of account %s not found", codeHash.Hex(), addr.Hex())
+		}
+	}
+
+	k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+		if storageLimit > 0 && uint64(len(dump.Storage)) == storageLimit {
+			dump.StorageTruncated = true
+			return false
+		}
+		dump.Storage = append(dump.Storage, types.NewState(key, value))
+		return true
+	})
+
+	return dump, nil
+}
+
+// GetProof returns the merkle proofs of the account at the given address and of the given storage
+// slots, made at the block height of the context, which must be committed (e.g a query context).
+// See types.AccountProof for the shape of the proofs.
+func (k *Keeper) GetProof(ctx sdk.Context, addr common.Address, storageKeys []common.Hash) (*types.AccountProof, error) {
+	if k.proofQuerier == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrNotSupported, "no store to query the proofs from")
+	}
+
+	height := ctx.BlockHeight()
+	accountKey := append(authtypes.AddressStoreKeyPrefix.Bytes(), addr.Bytes()...)
+	account, proof, err := k.queryProof(authtypes.StoreKey, accountKey, height)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to prove account %s", addr.Hex())
+	}
+
+	accountProof := &types.AccountProof{
+		Address:       addr,
+		Height:        height,
+		Account:       account,
+		Proof:         proof,
+		StorageProofs: make([]types.StorageProof, len(storageKeys)),
+	}
+	for i, key := range storageKeys {
+		value, proof, err := k.queryProof(types.StoreKey, types.StateKey(addr, key.Bytes()), height)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to prove storage slot %s", key.Hex())
+		}
+		accountProof.StorageProofs[i] = types.StorageProof{Key: key, Value: value, Proof: proof}
+	}
+
+	return accountProof, nil
+}
+
+// queryProof returns the value stored under the key of the given store at the given height, along
+// with its merkle proof.
+func (k *Keeper) queryProof(storeName string, key []byte, height int64) ([]byte, *cmtcrypto.ProofOps, error) {
+	res, err := k.proofQuerier.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Value, res.ProofOps, nil
+}
+
 // ----------------------------------------------------------------------------
 // Account
 // ----------------------------------------------------------------------------
 
+// SetGasCap sets the node's maximum gas cap for EstimateGas, requested caps above it are clamped.
+// A zero value disables the limit.
+func (k *Keeper) SetGasCap(gasCap uint64) *Keeper {
+	k.gasCap = gasCap
+	return k
+}
+
+// SetStrictExtraEIPs makes SetParams reject the extra EIPs already included in a hard fork active at
+// the current height. By default they are only logged as a warning.
+func (k *Keeper) SetStrictExtraEIPs(strict bool) *Keeper {
+	k.strictExtraEIPs = strict
+	return k
+}
+
+// SetLegacyParamsQuery enables the LegacyParams query, which exposes the params reconstructed from the
+// legacy subspace to compare them with the migrated ones. It is disabled by default.
+func (k *Keeper) SetLegacyParamsQuery(enabled bool) *Keeper {
+	k.legacyParamsQuery = enabled
+	return k
+}
+
+// SetProofQuerier sets the committed multi store queried for the merkle proofs of GetProof, which
+// fails until it is set.
+func (k *Keeper) SetProofQuerier(querier storetypes.Queryable) *Keeper {
+	k.proofQuerier = querier
+	return k
+}
+
+// SetHistoricalStore sets the committed multi store loaded at past versions by ReplayTx, which
+// fails until it is set.
+func (k *Keeper) SetHistoricalStore(store storetypes.MultiStore) *Keeper {
+	k.historicalStore = store
+	return k
+}
+
 // SetHooks sets the hooks for the EVM module
 // It should be called only once during initialization, it panic if called more than once.
 func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
@@ -310,6 +553,111 @@ func (k *Keeper) GetAccountOrEmpty(ctx sdk.Context, addr common.Address) statedb
 	}
 }
 
+// GetAccountsOrEmpty is the batch variant of GetAccountOrEmpty, it returns the accounts in the same
+// order as the given addresses, reading the evm denom only once for all of them.
+func (k *Keeper) GetAccountsOrEmpty(ctx sdk.Context, addrs []common.Address) []statedb.Account {
+	evmDenom := k.GetEVMDenom(ctx)
+
+	accounts := make([]statedb.Account, len(addrs))
+	for i, addr := range addrs {
+		acct := k.GetAccountWithoutBalance(ctx, addr)
+		if acct == nil {
+			// empty account
+			accounts[i] = statedb.Account{
+				Balance:  new(big.Int),
+				CodeHash: types.EmptyCodeHash,
+			}
+			continue
+		}
+
+		// if node is pruned, params is empty. Return invalid value
+		acct.Balance = big.NewInt(-1)
+		if evmDenom != "" {
+			acct.Balance = k.bankKeeper.GetBalance(ctx, sdk.AccAddress(addr.Bytes()), evmDenom).Amount.BigInt()
+		}
+		accounts[i] = *acct
+	}
+
+	return accounts
+}
+
+// EnsurePrecompileAccount creates the state account backing a precompile at the given address
+// if it doesn't exist yet: an EthAccount with nonce 1 and code 0x01, so the EVM never treats it as
+// empty. It's meant to be used by upgrade handlers that enable a precompile after genesis, calling
+// it again for an address that already holds the precompile code is a no-op.
+func (k *Keeper) EnsurePrecompileAccount(ctx sdk.Context, addr common.Address) error {
+	account := k.GetAccountOrEmpty(ctx, addr)
+	if bytes.Equal(account.CodeHash, precompileCodeHash) {
+		return nil
+	}
+
+	if !bytes.Equal(account.CodeHash, types.EmptyCodeHash) {
+		return errorsmod.Wrapf(types.ErrInvalidAccount, "address %s already has contract code", addr)
+	}
+
+	if account.Nonce == 0 {
+		account.Nonce = 1
+	}
+	account.CodeHash = precompileCodeHash
+
+	k.SetCode(ctx, precompileCodeHash, types.PrecompileCode)
+	return k.SetAccount(ctx, addr, account)
+}
+
+// ResetPrecompileAccount clears the code of a precompile account created by EnsurePrecompileAccount,
+// so that the address of a disabled precompile isn't mistaken for a contract later on. It must be
+// called explicitly (e.g. from an upgrade handler) once the precompile has been disabled. Addresses
+// that don't hold the precompile code are left untouched.
+func (k *Keeper) ResetPrecompileAccount(ctx sdk.Context, addr common.Address) error {
+	account := k.GetAccount(ctx, addr)
+	if account == nil || !bytes.Equal(account.CodeHash, precompileCodeHash) {
+		return nil
+	}
+
+	// NOTE: the code itself is keyed by hash and shared with the other precompile accounts
+	account.CodeHash = types.EmptyCodeHash
+	if err := k.SetAccount(ctx, addr, *account); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisablePrecompile,
+			sdk.NewAttribute(types.AttributeKeyPrecompile, addr.Hex()),
+		),
+	)
+	return nil
+}
+
+// ClassifyAccount tells whether the address is empty, an EOA, a contract or an enabled precompile.
+// Precompiles are the ones active for the current chain config and height, the custom precompiles
+// of the keeper and the accounts created by EnsurePrecompileAccount.
+func (k *Keeper) ClassifyAccount(ctx sdk.Context, addr common.Address) types.AccountKind {
+	if _, ok := k.customPrecompiles[addr]; ok {
+		return types.AccountKindPrecompile
+	}
+
+	ethCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.eip155ChainID)
+	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), ethCfg.MergeNetsplitBlock != nil)
+	for _, precompile := range vm.ActivePrecompiles(rules) {
+		if precompile == addr {
+			return types.AccountKindPrecompile
+		}
+	}
+
+	account := k.GetAccountOrEmpty(ctx, addr)
+	switch {
+	case bytes.Equal(account.CodeHash, precompileCodeHash):
+		return types.AccountKindPrecompile
+	case !bytes.Equal(account.CodeHash, types.EmptyCodeHash):
+		return types.AccountKindContract
+	case account.Nonce > 0 || account.Balance.Sign() > 0:
+		return types.AccountKindEOA
+	default:
+		return types.AccountKindEmpty
+	}
+}
+
 // GetNonce returns the sequence number of an account, returns 0 if not exists.
 func (k *Keeper) GetNonce(ctx sdk.Context, addr common.Address) uint64 {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
@@ -321,11 +669,78 @@ func (k *Keeper) GetNonce(ctx sdk.Context, addr common.Address) uint64 {
 	return acct.GetSequence()
 }
 
+// GetPendingNonce returns the next nonce of an account, including the transactions accepted by the
+// mempool of the node but not committed yet. It's equal to GetNonce when the account has no pending
+// transaction.
+func (k *Keeper) GetPendingNonce(ctx sdk.Context, addr common.Address) uint64 {
+	nonce := k.GetNonce(ctx, addr)
+	if pending, found := k.pendingNonces.get(addr); found && pending > nonce {
+		return pending
+	}
+	return nonce
+}
+
+// SetPendingNonce records the next nonce of a sender once its transaction has been accepted by the
+// mempool. It's called by the ante handler on CheckTx, lower nonces than the recorded one are
+// ignored.
+func (k *Keeper) SetPendingNonce(addr common.Address, nonce uint64) {
+	k.pendingNonces.set(addr, nonce)
+}
+
+// ResetPendingNonces forgets the recorded pending nonces. It's called once a block is committed,
+// before the mempool transactions are checked again against the new state and record their nonces
+// again.
+func (k *Keeper) ResetPendingNonces() {
+	k.pendingNonces.reset()
+}
+
+// IncrementNonce increments the nonce of the given address by one and returns the new nonce. The
+// account is created if it doesn't exist yet. It returns core.ErrNonceMax instead of wrapping
+// around if the nonce is already at its max value.
+func (k *Keeper) IncrementNonce(ctx sdk.Context, addr common.Address) (uint64, error) {
+	cosmosAddr := sdk.AccAddress(addr.Bytes())
+	acct := k.accountKeeper.GetAccount(ctx, cosmosAddr)
+	if acct == nil {
+		acct = k.accountKeeper.NewAccountWithAddress(ctx, cosmosAddr)
+	}
+
+	nonce := acct.GetSequence()
+	if nonce == math.MaxUint64 {
+		return 0, errorsmod.Wrapf(core.ErrNonceMax, "address %s, nonce %d", addr, nonce)
+	}
+
+	if err := acct.SetSequence(nonce + 1); err != nil {
+		return 0, err
+	}
+	k.accountKeeper.SetAccount(ctx, acct)
+
+	return nonce + 1, nil
+}
+
+// ValidateNonceGap returns the gap between the given tx nonce and the sender's account nonce, a
+// positive gap means the tx has a future nonce and has to be queued until the gap is filled. It
+// returns core.ErrNonceTooLow if the tx nonce has already been used, and ErrInvalidSequence if the gap
+// doesn't fit in an int64.
+func (k *Keeper) ValidateNonceGap(ctx sdk.Context, sender common.Address, txNonce uint64) (int64, error) {
+	nonce := k.GetNonce(ctx, sender)
+	if txNonce < nonce {
+		return 0, errorsmod.Wrapf(core.ErrNonceTooLow, "address %s, tx nonce %d < account nonce %d", sender, txNonce, nonce)
+	}
+	gap := txNonce - nonce
+	if gap > math.MaxInt64 {
+		return 0, errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"address %s, tx nonce %d is too far ahead of account nonce %d", sender, txNonce, nonce,
+		)
+	}
+
+	return int64(gap), nil
+}
+
 // GetBalance load account's balance of gas token
 func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
-	evmParams := k.GetParams(ctx)
-	evmDenom := evmParams.GetEvmDenom()
+	evmDenom := k.GetEVMDenom(ctx)
 	// if node is pruned, params is empty. Return invalid value
 	if evmDenom == "" {
 		return big.NewInt(-1)
@@ -354,6 +769,47 @@ func (k Keeper) getBaseFee(ctx sdk.Context, london bool) *big.Int {
 	return baseFee
 }
 
+// GetBaseFeeOrZero is the non-nil variant of GetBaseFee, for the callers that only need a value to
+// compute fees with. It returns:
+// - `0`: london hardfork not enabled, or feemarket not enabled.
+// - `n`: both london hardfork and feemarket are enabled.
+//
+// NOTE: a nil base fee selects the pre-London rules when building a message or a transaction, use
+// GetBaseFee in that case.
+func (k Keeper) GetBaseFeeOrZero(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int {
+	if baseFee := k.GetBaseFee(ctx, ethCfg); baseFee != nil {
+		return baseFee
+	}
+	return big.NewInt(0)
+}
+
+// CheckBaseFeeConfig returns an error when the fee market and the London hard fork disagree on whether
+// the base fee is active at the current height. The base fee of an enabled fee market is ignored
+// before London, while after London a disabled fee market gives a zero base fee.
+func (k Keeper) CheckBaseFeeConfig(ctx sdk.Context) error {
+	ethCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.ChainID())
+	london := types.IsLondon(ethCfg, ctx.BlockHeight())
+
+	fmParams := k.feeMarketKeeper.GetParams(ctx)
+	feeMarket := !fmParams.NoBaseFee && ctx.BlockHeight() >= fmParams.EnableHeight
+
+	switch {
+	case feeMarket && !london:
+		return errorsmod.Wrapf(types.ErrBaseFeeConfigMismatch, "fee market enabled but london hard fork not active at height %d", ctx.BlockHeight())
+	case !feeMarket && london:
+		return errorsmod.Wrapf(types.ErrBaseFeeConfigMismatch, "london hard fork active but fee market disabled at height %d", ctx.BlockHeight())
+	}
+	return nil
+}
+
+// WarnBaseFeeConfig logs a warning when the fee market and the London hard fork configurations are
+// inconsistent, see CheckBaseFeeConfig.
+func (k Keeper) WarnBaseFeeConfig(ctx sdk.Context) {
+	if err := k.CheckBaseFeeConfig(ctx); err != nil {
+		k.Logger(ctx).Warn("inconsistent base fee configuration", "error", err.Error())
+	}
+}
+
 // GetMinGasMultiplier returns the MinGasMultiplier param from the fee market module
 func (k Keeper) GetMinGasMultiplier(ctx sdk.Context) sdkmath.LegacyDec {
 	fmkParmas := k.feeMarketKeeper.GetParams(ctx)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	_ "embed"
 	"encoding/json"
 	"math"
@@ -12,17 +13,20 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/simapp"
+	"cosmossdk.io/store/rootmulti"
 	tmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -39,11 +43,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
@@ -233,10 +239,18 @@ func (suite *KeeperTestSuite) EvmDenom() string {
 	return rsp.Params.EvmDenom
 }
 
+// Commit commits a block, advancing the block time by one second.
 func (suite *KeeperTestSuite) Commit() {
+	suite.CommitAfter(time.Second)
+}
+
+// CommitAfter commits a block, advancing the block time by the given duration.
+func (suite *KeeperTestSuite) CommitAfter(t time.Duration) {
 	header := suite.ctx.BlockHeader()
 	_, err := suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{
-		Height: header.Height,
+		Height:          header.Height,
+		Time:            header.Time,
+		ProposerAddress: header.ProposerAddress,
 	})
 	suite.Require().NoError(err)
 
@@ -244,8 +258,11 @@ func (suite *KeeperTestSuite) Commit() {
 	suite.Require().NoError(err)
 
 	header.Height += 1
+	header.Time = header.Time.Add(t)
 	suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{
-		Height: header.Height,
+		Height:          header.Height,
+		Time:            header.Time,
+		ProposerAddress: header.ProposerAddress,
 	})
 
 	// update ctx
@@ -454,6 +471,147 @@ func (suite *KeeperTestSuite) TestBaseFee() {
 	suite.enableLondonHF = true
 }
 
+func (suite *KeeperTestSuite) TestCheckBaseFeeConfig() {
+	testCases := []struct {
+		name            string
+		enableLondonHF  bool
+		enableFeemarket bool
+		expectMismatch  bool
+	}{
+		{"not enable london HF, not enable feemarket", false, false, false},
+		{"enable london HF, not enable feemarket", true, false, true},
+		{"enable london HF, enable feemarket", true, true, false},
+		{"not enable london HF, enable feemarket", false, true, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = tc.enableFeemarket
+			suite.enableLondonHF = tc.enableLondonHF
+			suite.SetupTest()
+
+			var buf bytes.Buffer
+			ctx := suite.ctx.WithLogger(log.NewLogger(&buf))
+
+			err := suite.app.EvmKeeper.CheckBaseFeeConfig(ctx)
+			suite.app.EvmKeeper.WarnBaseFeeConfig(ctx)
+			if tc.expectMismatch {
+				suite.Require().ErrorIs(err, types.ErrBaseFeeConfigMismatch)
+				suite.Require().Contains(buf.String(), "inconsistent base fee configuration")
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Empty(buf.String())
+			}
+		})
+	}
+	suite.enableFeemarket = false
+	suite.enableLondonHF = true
+}
+
+func (suite *KeeperTestSuite) TestBaseFeeOpcode() {
+	// init code returning the BASEFEE word: BASEFEE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	initCode := hexutil.MustDecode("0x4860005260206000f3")
+
+	testCases := []struct {
+		name            string
+		enableLondonHF  bool
+		enableFeemarket bool
+		extraEIPs       []int64
+		expBaseFee      *big.Int // nil if the opcode is unavailable
+	}{
+		{"london, feemarket", true, true, nil, big.NewInt(1000000000)},
+		{"london, no feemarket", true, false, nil, big.NewInt(0)},
+		{"no london, EIP-3198 enabled", false, false, []int64{3198}, big.NewInt(0)},
+		{"no london, EIP-3198 not enabled", false, false, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = tc.enableFeemarket
+			suite.enableLondonHF = tc.enableLondonHF
+			suite.SetupTest()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.ExtraEIPs = tc.extraEIPs
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			args, err := json.Marshal(&types.TransactionArgs{
+				From: &suite.address,
+				Data: (*hexutil.Bytes)(&initCode),
+			})
+			suite.Require().NoError(err)
+			res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{
+				Args:            args,
+				GasCap:          uint64(config.DefaultGasCap),
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+			})
+			suite.Require().NoError(err)
+
+			if tc.expBaseFee == nil {
+				suite.Require().Contains(res.VmError, "invalid opcode: BASEFEE")
+				return
+			}
+			suite.Require().Empty(res.VmError)
+			baseFee := new(big.Int).SetBytes(res.Ret)
+			suite.Require().Equal(tc.expBaseFee.String(), baseFee.String())
+
+			ethCfg := params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+			suite.Require().Equal(suite.app.EvmKeeper.GetBaseFeeOrZero(suite.ctx, ethCfg).String(), baseFee.String())
+		})
+	}
+	suite.enableFeemarket = false
+	suite.enableLondonHF = true
+}
+
+func (suite *KeeperTestSuite) TestWithChainID() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	// same chain id, no-op
+	suite.Require().NotPanics(func() {
+		suite.app.EvmKeeper.WithChainID(suite.ctx)
+	})
+	suite.Require().Equal(chainID, suite.app.EvmKeeper.ChainID())
+
+	// conflicting chain id
+	suite.Require().PanicsWithValue(
+		"chain id already set to 9000, got conflicting chain id 9001 (ethermint_9001-1)",
+		func() {
+			suite.app.EvmKeeper.WithChainID(suite.ctx.WithChainID("ethermint_9001-1"))
+		},
+	)
+	suite.Require().Equal(chainID, suite.app.EvmKeeper.ChainID())
+}
+
+func (suite *KeeperTestSuite) TestBaseFeeOrZero() {
+	testCases := []struct {
+		name            string
+		enableLondonHF  bool
+		enableFeemarket bool
+		expectBaseFee   *big.Int
+	}{
+		{"not enable london HF, not enable feemarket", false, false, big.NewInt(0)},
+		{"enable london HF, not enable feemarket", true, false, big.NewInt(0)},
+		{"enable london HF, enable feemarket", true, true, big.NewInt(1000000000)},
+		{"not enable london HF, enable feemarket", false, true, big.NewInt(0)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = tc.enableFeemarket
+			suite.enableLondonHF = tc.enableLondonHF
+			suite.SetupTest()
+			suite.app.EvmKeeper.BeginBlock(suite.ctx)
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			ethCfg := params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+			baseFee := suite.app.EvmKeeper.GetBaseFeeOrZero(suite.ctx, ethCfg)
+			suite.Require().Equal(tc.expectBaseFee, baseFee)
+		})
+	}
+	suite.enableFeemarket = false
+	suite.enableLondonHF = true
+}
+
 func (suite *KeeperTestSuite) TestGetAccountStorage() {
 	testCases := []struct {
 		name     string
@@ -498,6 +656,77 @@ func (suite *KeeperTestSuite) TestGetAccountStorage() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGetStorageRoot() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	addr1, addr2, addr3 := tests.GenerateAddress(), tests.GenerateAddress(), tests.GenerateAddress()
+
+	emptyRoot := k.GetStorageRoot(suite.ctx, addr1)
+	suite.Require().Equal(crypto.Keccak256Hash(), emptyRoot)
+
+	slots := map[common.Hash]common.Hash{
+		common.BigToHash(big.NewInt(1)): common.BigToHash(big.NewInt(100)),
+		common.BigToHash(big.NewInt(2)): common.BigToHash(big.NewInt(200)),
+	}
+	for key, value := range slots {
+		k.SetState(suite.ctx, addr1, key, value.Bytes())
+		k.SetState(suite.ctx, addr2, key, value.Bytes())
+	}
+	k.SetState(suite.ctx, addr3, common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(100)).Bytes())
+	k.SetState(suite.ctx, addr3, common.BigToHash(big.NewInt(2)), common.BigToHash(big.NewInt(201)).Bytes())
+
+	root := k.GetStorageRoot(suite.ctx, addr1)
+	suite.Require().NotEqual(emptyRoot, root)
+	suite.Require().Equal(root, k.GetStorageRoot(suite.ctx, addr2))
+	suite.Require().NotEqual(root, k.GetStorageRoot(suite.ctx, addr3))
+}
+
+func (suite *KeeperTestSuite) TestGetProof() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	key, unsetKey := common.BigToHash(big.NewInt(100)), common.BigToHash(big.NewInt(101))
+	value := common.BigToHash(big.NewInt(42))
+	k.SetState(suite.ctx, contractAddr, key, value.Bytes())
+	suite.Commit()
+
+	// the proofs are made against the app hash of the last committed height
+	height := suite.app.LastBlockHeight()
+	root := suite.app.LastCommitID().Hash
+	proof, err := k.GetProof(suite.ctx.WithBlockHeight(height), contractAddr, []common.Hash{key, unsetKey})
+	suite.Require().NoError(err)
+	suite.Require().Equal(contractAddr, proof.Address)
+	suite.Require().Equal(height, proof.Height)
+
+	keyPath := func(storeName string, key []byte) string {
+		var path merkle.KeyPath
+		return path.AppendKey([]byte(storeName), merkle.KeyEncodingURL).AppendKey(key, merkle.KeyEncodingHex).String()
+	}
+	prt := rootmulti.DefaultProofRuntime()
+
+	var account sdk.AccountI
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalInterface(proof.Account, &account))
+	suite.Require().Equal(sdk.AccAddress(contractAddr.Bytes()), account.GetAddress())
+	accountKey := append(authtypes.AddressStoreKeyPrefix.Bytes(), contractAddr.Bytes()...)
+	suite.Require().NoError(prt.VerifyValue(proof.Proof, root, keyPath(authtypes.StoreKey, accountKey), proof.Account))
+
+	suite.Require().Len(proof.StorageProofs, 2)
+	slot := proof.StorageProofs[0]
+	suite.Require().Equal(key, slot.Key)
+	suite.Require().Equal(value.Bytes(), slot.Value)
+	slotPath := keyPath(types.StoreKey, types.StateKey(contractAddr, key.Bytes()))
+	suite.Require().NoError(prt.VerifyValue(slot.Proof, root, slotPath, slot.Value))
+	// a different value doesn't verify
+	suite.Require().Error(prt.VerifyValue(slot.Proof, root, slotPath, common.BigToHash(big.NewInt(43)).Bytes()))
+
+	// the unset slot is proven absent
+	slot = proof.StorageProofs[1]
+	suite.Require().Equal(unsetKey, slot.Key)
+	suite.Require().Empty(slot.Value)
+	suite.Require().NoError(prt.VerifyAbsence(slot.Proof, root, keyPath(types.StoreKey, types.StateKey(contractAddr, unsetKey.Bytes()))))
+}
+
 func (suite *KeeperTestSuite) TestGetAccountOrEmpty() {
 	empty := statedb.Account{
 		Balance:  new(big.Int),
@@ -535,3 +764,198 @@ func (suite *KeeperTestSuite) TestGetAccountOrEmpty() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCommitAfter() {
+	suite.SetupTest()
+	start := suite.ctx.BlockTime()
+	height := suite.ctx.BlockHeight()
+
+	suite.CommitAfter(time.Minute)
+	suite.Require().Equal(start.Add(time.Minute), suite.ctx.BlockTime())
+
+	suite.CommitAfter(time.Hour)
+	suite.Require().Equal(start.Add(time.Minute+time.Hour), suite.ctx.BlockTime())
+	suite.Require().Equal(height+2, suite.ctx.BlockHeight())
+
+	// the query client is rebuilt against the new context
+	res, err := suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.EvmKeeper.GetParams(suite.ctx), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestGetAccountsOrEmpty() {
+	supply := big.NewInt(100)
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, supply)
+
+	addrs := []common.Address{
+		{},
+		contractAddr,
+		suite.address,
+		tests.GenerateAddress(),
+	}
+
+	res := suite.app.EvmKeeper.GetAccountsOrEmpty(suite.ctx, addrs)
+	suite.Require().Len(res, len(addrs))
+	for i, addr := range addrs {
+		suite.Require().Equal(suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, addr), res[i])
+	}
+
+	suite.Require().Empty(suite.app.EvmKeeper.GetAccountsOrEmpty(suite.ctx, nil))
+}
+
+func (suite *KeeperTestSuite) TestEnsurePrecompileAccount() {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000100")
+	code := []byte{0x01}
+	codeHash := crypto.Keccak256Hash(code)
+
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, addr))
+
+	for i := 0; i < 2; i++ {
+		suite.Require().NoError(suite.app.EvmKeeper.EnsurePrecompileAccount(suite.ctx, addr))
+
+		acct := suite.app.EvmKeeper.GetAccount(suite.ctx, addr)
+		suite.Require().NotNil(acct)
+		suite.Require().Equal(uint64(1), acct.Nonce)
+		suite.Require().Equal(codeHash.Bytes(), acct.CodeHash)
+		suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, codeHash))
+	}
+
+	// an address holding contract code can't be turned into a precompile account
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100))
+	err := suite.app.EvmKeeper.EnsurePrecompileAccount(suite.ctx, contractAddr)
+	suite.Require().ErrorIs(err, types.ErrInvalidAccount)
+}
+
+func (suite *KeeperTestSuite) TestResetPrecompileAccount() {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000100")
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100))
+	contractCodeHash := suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr).CodeHash
+
+	testCases := []struct {
+		name     string
+		reset    bool
+		expReset bool
+	}{
+		{"precompile disabled without cleanup", false, false},
+		{"precompile disabled with cleanup", true, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().NoError(suite.app.EvmKeeper.EnsurePrecompileAccount(suite.ctx, addr))
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			if tc.reset {
+				suite.Require().NoError(suite.app.EvmKeeper.ResetPrecompileAccount(ctx, addr))
+			}
+
+			acct := suite.app.EvmKeeper.GetAccount(ctx, addr)
+			suite.Require().NotNil(acct)
+			if !tc.expReset {
+				suite.Require().Equal(crypto.Keccak256([]byte{0x01}), acct.CodeHash)
+				suite.Require().Empty(ctx.EventManager().Events())
+				return
+			}
+
+			suite.Require().Equal(types.EmptyCodeHash, acct.CodeHash)
+			events := ctx.EventManager().Events()
+			suite.Require().Len(events, 1)
+			suite.Require().Equal(types.EventTypeDisablePrecompile, events[0].Type)
+			suite.Require().Equal(addr.Hex(), events[0].Attributes[0].Value)
+		})
+	}
+
+	// contracts are never reset
+	suite.Require().NoError(suite.app.EvmKeeper.ResetPrecompileAccount(suite.ctx, contractAddr))
+	suite.Require().Equal(contractCodeHash, suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr).CodeHash)
+}
+
+func (suite *KeeperTestSuite) TestClassifyAccount() {
+	suite.SetupTest()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100))
+	precompileAddr := common.HexToAddress("0x0000000000000000000000000000000000000100")
+	suite.Require().NoError(suite.app.EvmKeeper.EnsurePrecompileAccount(suite.ctx, precompileAddr))
+
+	testCases := []struct {
+		name    string
+		addr    common.Address
+		expKind types.AccountKind
+	}{
+		{"empty address", tests.GenerateAddress(), types.AccountKindEmpty},
+		{"funded EOA", suite.address, types.AccountKindEOA},
+		{"deployed contract", contractAddr, types.AccountKindContract},
+		{"active geth precompile", common.BytesToAddress([]byte{0x01}), types.AccountKindPrecompile},
+		{"precompile account", precompileAddr, types.AccountKindPrecompile},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			kind := suite.app.EvmKeeper.ClassifyAccount(suite.ctx, tc.addr)
+			suite.Require().Equal(tc.expKind, kind, kind.String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIncrementNonce() {
+	suite.SetupTest()
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.address.Bytes())
+	suite.Require().NoError(acc.SetSequence(math.MaxUint64 - 1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	nonce, err := suite.app.EvmKeeper.IncrementNonce(suite.ctx, suite.address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(math.MaxUint64), nonce)
+	suite.Require().Equal(uint64(math.MaxUint64), suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+
+	_, err = suite.app.EvmKeeper.IncrementNonce(suite.ctx, suite.address)
+	suite.Require().ErrorIs(err, core.ErrNonceMax)
+	suite.Require().Equal(uint64(math.MaxUint64), suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+
+	// accounts that don't exist yet are created
+	addr := tests.GenerateAddress()
+	nonce, err = suite.app.EvmKeeper.IncrementNonce(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), nonce)
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(suite.ctx, addr.Bytes()))
+}
+
+func (suite *KeeperTestSuite) TestValidateNonceGap() {
+	suite.SetupTest()
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.address.Bytes())
+	suite.Require().NoError(acc.SetSequence(10))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	testCases := []struct {
+		name    string
+		txNonce uint64
+		expGap  int64
+		expErr  error
+	}{
+		{"in order nonce", 10, 0, nil},
+		{"future nonce", 13, 3, nil},
+		{"largest gap", 10 + math.MaxInt64, math.MaxInt64, nil},
+		{"stale nonce", 9, 0, core.ErrNonceTooLow},
+		{"gap overflowing int64", math.MaxUint64, 0, errortypes.ErrInvalidSequence},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			gap, err := suite.app.EvmKeeper.ValidateNonceGap(suite.ctx, suite.address, tc.txNonce)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGap, gap)
+		})
+	}
+
+	// accounts that don't exist yet have nonce 0
+	gap, err := suite.app.EvmKeeper.ValidateNonceGap(suite.ctx, tests.GenerateAddress(), 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), gap)
+}
@@ -0,0 +1,251 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetLogs stores the logs emitted by a transaction under the current block height, keyed by their
+// index in the block. It also records the height of the logs' block hash, so they can be looked up
+// by block hash. The logs are kept for types.HistoryRetentionBlocks blocks.
+func (k Keeper) SetLogs(ctx sdk.Context, logs []*ethtypes.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	height := uint64(ctx.BlockHeight())
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.BlockHeightKey(logs[0].BlockHash), sdk.Uint64ToBigEndian(height))
+
+	logStore := prefix.NewStore(store, types.BlockLogsPrefix(height))
+	for _, log := range logs {
+		bz, err := k.cdc.Marshal(types.NewLogFromEth(log))
+		if err != nil {
+			return err
+		}
+		logStore.Set(sdk.Uint64ToBigEndian(uint64(log.Index)), bz)
+	}
+
+	return nil
+}
+
+// GetLogsByHeight returns all the logs emitted on the given block height, ordered by log index.
+func (k Keeper) GetLogsByHeight(ctx sdk.Context, height uint64) ([]*ethtypes.Log, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.BlockLogsPrefix(height))
+	defer iterator.Close()
+
+	var logs []*ethtypes.Log
+	for ; iterator.Valid(); iterator.Next() {
+		var log types.Log
+		if err := k.cdc.Unmarshal(iterator.Value(), &log); err != nil {
+			return nil, err
+		}
+		logs = append(logs, log.ToEthereum())
+	}
+
+	return logs, nil
+}
+
+// GetLogsByBlock returns all the logs emitted on the block with the given hash, ordered by log
+// index. It returns no logs if the block is unknown or had no logs.
+func (k Keeper) GetLogsByBlock(ctx sdk.Context, blockHash common.Hash) ([]*ethtypes.Log, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.BlockHeightKey(blockHash))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	return k.GetLogsByHeight(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// PruneLogs deletes the logs emitted on the given block height, along with the height recorded for
+// their block hash.
+func (k Keeper) PruneLogs(ctx sdk.Context, height uint64) error {
+	logs, err := k.GetLogsByHeight(ctx, height)
+	if err != nil {
+		return err
+	}
+	if len(logs) == 0 {
+		return nil
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.BlockHeightKey(logs[0].BlockHash))
+
+	logStore := prefix.NewStore(store, types.BlockLogsPrefix(height))
+	for _, log := range logs {
+		logStore.Delete(sdk.Uint64ToBigEndian(uint64(log.Index)))
+	}
+
+	return nil
+}
+
+// MaxFilterBlockRange is the maximum number of blocks FilterLogs scans for a single query, the same as
+// the default JSON-RPC block-range-cap.
+const MaxFilterBlockRange = 10_000
+
+// FilterLogs returns the stored logs matching the given criteria. If the criteria defines a block
+// hash, only that block is scanned, otherwise every block within the [FromBlock, ToBlock] range is.
+// A nil or negative (i.e latest/pending) block number resolves to the current height, and the range
+// is clamped to the current height. Ranges wider than MaxFilterBlockRange are rejected.
+func (k Keeper) FilterLogs(ctx sdk.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+	if crit.BlockHash != nil {
+		logs, err := k.GetLogsByBlock(ctx, *crit.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		return filterLogs(logs, crit.Addresses, crit.Topics), nil
+	}
+
+	height := uint64(ctx.BlockHeight())
+	from := height
+	if crit.FromBlock != nil && crit.FromBlock.Sign() >= 0 {
+		from = crit.FromBlock.Uint64()
+	}
+	to := height
+	if crit.ToBlock != nil && crit.ToBlock.Sign() >= 0 {
+		to = crit.ToBlock.Uint64()
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from block %d is greater than to block %d", from, to)
+	}
+
+	// the future blocks don't have any logs yet
+	if to > height {
+		to = height
+	}
+	if from > to {
+		return nil, nil
+	}
+	if to-from >= MaxFilterBlockRange {
+		return nil, fmt.Errorf("invalid block range: %d blocks exceed the limit of %d", to-from+1, MaxFilterBlockRange)
+	}
+
+	var matched []*ethtypes.Log
+	for h := from; h <= to; h++ {
+		// skip the blocks that can't contain any matching log
+		bloom, err := k.GetBlockBloom(ctx, h)
+		if err != nil {
+			return nil, err
+		}
+		if h == height && bloom == (ethtypes.Bloom{}) {
+			// the block is still executing, its bloom is only stored on EndBlock
+			bloom = ethtypes.BytesToBloom(k.GetBlockBloomTransient(ctx).Bytes())
+		}
+		if !bloomFilter(bloom, crit.Addresses, crit.Topics) {
+			continue
+		}
+
+		logs, err := k.GetLogsByHeight(ctx, h)
+		if err != nil {
+			return nil, err
+		}
+		matched = append(matched, filterLogs(logs, crit.Addresses, crit.Topics)...)
+	}
+
+	return matched, nil
+}
+
+// filterLogs returns the logs matching the given addresses and topics, following the same rules as
+// the eth_getLogs JSON-RPC filters:
+// [] -> anything
+// [A] -> A in first position of log topics, anything after
+// [null, B] -> anything in first position, B in second position
+// [A, B] -> A in first position and B in second position
+// [[A, B], [A, B]] -> A or B in first position, A or B in second position
+func filterLogs(logs []*ethtypes.Log, addresses []common.Address, topics [][]common.Hash) []*ethtypes.Log {
+	var ret []*ethtypes.Log
+Logs:
+	for _, log := range logs {
+		if len(addresses) > 0 && !includesAddress(addresses, log.Address) {
+			continue
+		}
+		// If the to filtered topics is greater than the amount of topics in logs, skip.
+		if len(topics) > len(log.Topics) {
+			continue
+		}
+		for i, sub := range topics {
+			match := len(sub) == 0 // empty rule set == wildcard
+			for _, topic := range sub {
+				if log.Topics[i] == topic {
+					match = true
+					break
+				}
+			}
+			if !match {
+				continue Logs
+			}
+		}
+		ret = append(ret, log)
+	}
+	return ret
+}
+
+// bloomFilter returns false if the bloom proves that no log can match the given addresses and topics.
+func bloomFilter(bloom ethtypes.Bloom, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		var included bool
+		for _, addr := range addresses {
+			if ethtypes.BloomLookup(bloom, addr) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	for _, sub := range topics {
+		included := len(sub) == 0 // empty rule set == wildcard
+		for _, topic := range sub {
+			if ethtypes.BloomLookup(bloom, topic) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	return true
+}
+
+func includesAddress(addresses []common.Address, a common.Address) bool {
+	for _, addr := range addresses {
+		if addr == a {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+
+	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestFilterLogs() {
+	suite.SetupTest()
+
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, big.NewInt(10))
+
+	blockHash := common.BytesToHash(suite.ctx.HeaderHash())
+	blockLogs, err := suite.app.EvmKeeper.GetLogsByBlock(suite.ctx, blockHash)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(blockLogs)
+
+	transferTopic := types.ERC20Contract.ABI.Events["Transfer"].ID
+	recipientTopic := common.BytesToHash(recipient.Bytes())
+
+	testCases := []struct {
+		name   string
+		crit   filters.FilterCriteria
+		expLen int
+	}{
+		{
+			"address and topics",
+			filters.FilterCriteria{
+				Addresses: []common.Address{contractAddr},
+				Topics:    [][]common.Hash{{transferTopic}, {}, {recipientTopic}},
+			},
+			1,
+		},
+		{
+			"address and topics on block hash",
+			filters.FilterCriteria{
+				BlockHash: &blockHash,
+				Addresses: []common.Address{contractAddr},
+				Topics:    [][]common.Hash{{transferTopic}, {}, {recipientTopic}},
+			},
+			1,
+		},
+		{
+			"address only",
+			filters.FilterCriteria{
+				Addresses: []common.Address{contractAddr},
+			},
+			len(blockLogs),
+		},
+		{
+			"other address",
+			filters.FilterCriteria{
+				Addresses: []common.Address{recipient},
+			},
+			0,
+		},
+		{
+			"block range clamped to the current height",
+			filters.FilterCriteria{
+				FromBlock: big.NewInt(suite.ctx.BlockHeight()),
+				ToBlock:   big.NewInt(suite.ctx.BlockHeight() + 1_000_000),
+				Addresses: []common.Address{contractAddr},
+			},
+			len(blockLogs),
+		},
+		{
+			"block range without logs",
+			filters.FilterCriteria{
+				FromBlock: big.NewInt(suite.ctx.BlockHeight() + 1),
+				ToBlock:   big.NewInt(suite.ctx.BlockHeight() + 2),
+			},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			logs, err := suite.app.EvmKeeper.FilterLogs(suite.ctx, tc.crit)
+			suite.Require().NoError(err)
+			suite.Require().Len(logs, tc.expLen)
+			for _, log := range logs {
+				suite.Require().Equal(contractAddr, log.Address)
+			}
+			if tc.expLen == 1 {
+				suite.Require().Equal(transferTopic, logs[0].Topics[0])
+				suite.Require().Equal(recipientTopic, logs[0].Topics[2])
+			}
+		})
+	}
+
+	_, err = suite.app.EvmKeeper.FilterLogs(suite.ctx, filters.FilterCriteria{
+		FromBlock: big.NewInt(suite.ctx.BlockHeight() + 1),
+		ToBlock:   big.NewInt(suite.ctx.BlockHeight()),
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.app.EvmKeeper.FilterLogs(suite.ctx.WithBlockHeight(keeper.MaxFilterBlockRange+1), filters.FilterCriteria{
+		FromBlock: big.NewInt(0),
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestFilterLogsCommittedBlock() {
+	suite.SetupTest()
+
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, big.NewInt(10))
+	height := suite.ctx.BlockHeight()
+	suite.Commit()
+
+	// the latest committed block is queried with an empty transient store
+	queryCtx, err := suite.app.CreateQueryContext(height, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(height, queryCtx.BlockHeight())
+
+	logs, err := suite.app.EvmKeeper.FilterLogs(queryCtx, filters.FilterCriteria{
+		Addresses: []common.Address{contractAddr},
+		Topics:    [][]common.Hash{{types.ERC20Contract.ABI.Events["Transfer"].ID}},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(logs, 1)
+	suite.Require().Equal(uint64(height), logs[0].BlockNumber)
+
+	// the committed block is skipped when its stored bloom doesn't match, even though it has logs
+	otherBloom := ethtypes.BytesToBloom(ethtypes.LogsBloom([]*ethtypes.Log{{Address: recipient}}))
+	suite.Require().NoError(suite.app.EvmKeeper.SetBlockBloom(queryCtx, uint64(height), otherBloom))
+	logs, err = suite.app.EvmKeeper.FilterLogs(queryCtx, filters.FilterCriteria{Addresses: []common.Address{contractAddr}})
+	suite.Require().NoError(err)
+	suite.Require().Empty(logs)
+	logs, err = suite.app.EvmKeeper.GetLogsByHeight(queryCtx, uint64(height))
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(logs)
+}
+
+func (suite *KeeperTestSuite) TestBlockLogPositions() {
+	suite.SetupTest()
+
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	transferTxs := []*types.MsgEthereumTx{
+		suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, big.NewInt(10)),
+		suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, big.NewInt(20)),
+	}
+
+	blockHash := common.BytesToHash(suite.ctx.HeaderHash())
+	logs, err := suite.app.EvmKeeper.GetLogsByBlock(suite.ctx, blockHash)
+	suite.Require().NoError(err)
+	// one Transfer log per transfer, the deployment doesn't emit any
+	suite.Require().Len(logs, len(transferTxs))
+
+	for i, log := range logs {
+		suite.Require().Equal(uint(i), log.Index)
+		// the deployment is the first tx of the block
+		suite.Require().Equal(uint(i+1), log.TxIndex)
+		suite.Require().Equal(transferTxs[i].AsTransaction().Hash(), log.TxHash)
+		suite.Require().Equal(blockHash, log.BlockHash)
+		suite.Require().Equal(uint64(suite.ctx.BlockHeight()), log.BlockNumber)
+	}
+	suite.Require().Equal(uint64(len(logs)), suite.app.EvmKeeper.GetLogSizeTransient(suite.ctx))
+}
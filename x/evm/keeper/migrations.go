@@ -19,6 +19,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v4 "github.com/evmos/ethermint/x/evm/migrations/v4"
 	v5 "github.com/evmos/ethermint/x/evm/migrations/v5"
+	v6 "github.com/evmos/ethermint/x/evm/migrations/v6"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -45,3 +46,43 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeService, m.keeper.accountKeeper, m.keeper.cdc)
+}
+
+// RunEVMMigrations brings the module state to the current consensus version whatever the format of
+// the stored params: managed by the params module (version 3), stored under separate keys
+// (version 4) or already under the single params key (version 5 and later). Each step is logged
+// and it can safely be run again once the state is migrated. The app upgrade handler runs it ahead of
+// the module manager migrations.
+func (m Migrator) RunEVMMigrations(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	store := m.keeper.storeService.OpenKVStore(ctx)
+
+	migrated, err := store.Has(types.KeyPrefixParams)
+	if err != nil {
+		return err
+	}
+	if !migrated {
+		legacyKeys, err := store.Has(types.ParamStoreKeyEVMDenom)
+		if err != nil {
+			return err
+		}
+		if !legacyKeys {
+			logger.Info("migrating the evm params from the params module to separate keys")
+			if err := m.Migrate3to4(ctx); err != nil {
+				return err
+			}
+		}
+
+		logger.Info("migrating the evm params from separate keys to the params key")
+		if err := m.Migrate4to5(ctx); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("migrating the code size limits and the code hash of the ethereum accounts")
+	return m.Migrate5to6(ctx)
+}
@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	v5 "github.com/evmos/ethermint/x/evm/migrations/v5"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -30,6 +32,10 @@ func (suite *KeeperTestSuite) TestMigrations() {
 			"Run Migrate3to4",
 			migrator.Migrate3to4,
 		},
+		{
+			"Run Migrate5to6",
+			migrator.Migrate5to6,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -39,3 +45,45 @@ func (suite *KeeperTestSuite) TestMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRunEVMMigrations() {
+	legacyParams := types.DefaultParams()
+	legacyParams.EvmDenom = "aphoton"
+	legacyParams.EnableCall = false
+	migrator := evmkeeper.NewMigrator(*suite.app.EvmKeeper, newMockSubspace(legacyParams))
+
+	// start from the params managed by the params module
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(types.KeyPrefixParams)
+
+	suite.Require().NoError(migrator.RunEVMMigrations(suite.ctx))
+	suite.Require().Equal(legacyParams, suite.app.EvmKeeper.GetParams(suite.ctx))
+	suite.Require().False(store.Has(types.ParamStoreKeyEVMDenom))
+
+	// running the migrations again is a no-op
+	suite.Require().NoError(migrator.RunEVMMigrations(suite.ctx))
+	suite.Require().Equal(legacyParams, suite.app.EvmKeeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestParamsAfterMigrate3to4() {
+	legacyParams := types.DefaultParams()
+	legacyParams.EvmDenom = "aphoton"
+	legacyParams.ExtraEIPs = []int64{2929}
+	legacyParams.AllowUnprotectedTxs = true
+	migrator := evmkeeper.NewMigrator(*suite.app.EvmKeeper, newMockSubspace(legacyParams))
+
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(types.KeyPrefixParams)
+
+	// the params stored in separate keys are read by the migration moving them to the params key
+	suite.Require().NoError(migrator.Migrate3to4(suite.ctx))
+	suite.Require().True(store.Has(types.ParamStoreKeyExtraEIPs))
+	storeService := runtime.NewKVStoreService(suite.app.GetKey(types.StoreKey))
+	params, err := v5.LoadParams(storeService.OpenKVStore(suite.ctx), suite.app.AppCodec())
+	suite.Require().NoError(err)
+	suite.Require().Equal(legacyParams, params)
+
+	suite.Require().NoError(migrator.Migrate4to5(suite.ctx))
+	suite.Require().False(store.Has(types.ParamStoreKeyExtraEIPs))
+	suite.Require().Equal(legacyParams, suite.app.EvmKeeper.GetParams(suite.ctx))
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -57,6 +58,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
+	start := time.Now()
 	response, err := k.ApplyTransaction(ctx, msg)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
@@ -69,6 +71,30 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 			labels,
 		)
 
+		status := "success"
+		if response.Failed() {
+			status = "failed"
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "msg", "ethereum_tx", "status"},
+			1,
+			append([]metrics.Label{telemetry.NewLabel("status", status)}, labels...),
+		)
+
+		// the distributions of the gas used and of the execution time of the txs
+		if telemetry.IsTelemetryEnabled() {
+			metrics.AddSampleWithLabels(
+				[]string{"tx", "msg", "ethereum_tx", "gas_used"},
+				float32(response.GasUsed),
+				labels,
+			)
+			metrics.MeasureSinceWithLabels(
+				[]string{"tx", "msg", "ethereum_tx", "latency"},
+				start,
+				labels,
+			)
+		}
+
 		if response.GasUsed != 0 {
 			telemetry.IncrCounterWithLabels(
 				[]string{"tx", "msg", "ethereum_tx", "gas_used", "total"},
@@ -104,6 +130,15 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		// add event for tendermint transaction hash format
 		hash := tmbytes.HexBytes(tmtypes.Tx(ctx.TxBytes()).Hash())
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxHash, hash.String()))
+
+		info := types.CosmosTxInfo{
+			CosmosTxHash: hash.String(),
+			BlockHeight:  uint64(ctx.BlockHeight()),
+			TxIndex:      txIndex,
+		}
+		if err := k.SetCosmosTxInfo(ctx, tx.Hash(), info); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to store cosmos tx info")
+		}
 	}
 
 	if to := tx.To(); to != nil {
@@ -168,3 +203,40 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ResetParams implements the gRPC MsgServer interface. When a ResetParams
+// proposal passes, the module parameters are reset to their default values,
+// except the evm denom and the chain config unless requested. An event lists
+// the changed parameters.
+func (k *Keeper) ResetParams(goCtx context.Context, req *types.MsgResetParams) (*types.MsgResetParamsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Authority); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	oldParams := k.GetParams(ctx)
+
+	params := types.DefaultParams()
+	if !req.ResetEvmDenom {
+		params.EvmDenom = oldParams.EvmDenom
+	}
+	if !req.ResetChainConfig {
+		params.ChainConfig = oldParams.ChainConfig
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	attrs := []sdk.Attribute{}
+	for _, name := range oldParams.Diff(params) {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyParam, name))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeResetParams, attrs...))
+
+	return &types.MsgResetParamsResponse{}, nil
+}
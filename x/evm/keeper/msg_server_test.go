@@ -2,14 +2,23 @@ package keeper_test
 
 import (
 	"math/big"
+	"strings"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
+	"github.com/hashicorp/go-metrics"
 )
 
 func (suite *KeeperTestSuite) TestEthereumTx() {
@@ -82,6 +91,181 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthereumTxMaxCodeSize() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.MaxCodeSize = 100
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+	suite.Require().NoError(err)
+	data := append(types.ERC20Contract.Bin, ctorArgs...)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := types.NewTxContract(chainID, nonce, nil, 2_000_000, nil, nil, nil, data, nil)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	res, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, types.ErrMaxCodeSizeExceeded.Error())
+	suite.Require().Equal(uint64(2_000_000), res.GasUsed)
+
+	// the creation is reverted, but the nonce is still consumed
+	contractAddr := crypto.CreateAddress(suite.address, nonce)
+	suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, contractAddr).CodeHash)))
+	suite.Require().Equal(nonce+1, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+}
+
+func (suite *KeeperTestSuite) TestEthereumTxMaxCodeSizeFactory() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.MaxCodeSize = 100
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+
+	codeSize := func(addr common.Address) int {
+		codeHash := suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, addr).CodeHash
+		return suite.app.EvmKeeper.GetCodeSize(suite.ctx, common.BytesToHash(codeHash))
+	}
+	send := func(to *common.Address, data []byte) *types.MsgEthereumTxResponse {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg := types.NewTx(chainID, nonce, to, nil, 1_000_000, nil, nil, nil, data, nil)
+		msg.From = suite.address.Hex()
+		suite.Require().NoError(msg.Sign(ethSigner, suite.signer))
+		res, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// deployFactory deploys a contract which, when called, creates a child contract of the given code
+	// size with CREATE and stores its address in the slot 0.
+	deployFactory := func(childSize uint64) common.Address {
+		// PUSH2 childSize PUSH1 0 RETURN
+		childInit := []byte{0x61, byte(childSize >> 8), byte(childSize), 0x60, 0x00, 0xf3}
+		// PUSH6 childInit PUSH1 0 MSTORE, CREATE(0, 26, 6), PUSH1 0 SSTORE, STOP
+		runtime := append(append([]byte{0x65}, childInit...), common.FromHex("0x6000526006601a6000f060005500")...)
+		// CODECOPY the runtime code and return it
+		init := append(common.FromHex("0x6015600c60003960156000f3"), runtime...)
+
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		res := send(nil, init)
+		suite.Require().False(res.Failed(), res.VmError)
+		return crypto.CreateAddress(suite.address, nonce)
+	}
+
+	// a factory can't deploy a code larger than the limit
+	factory := deployFactory(200)
+	res := send(&factory, nil)
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, types.ErrMaxCodeSizeExceeded.Error())
+	suite.Require().Equal(uint64(1_000_000), res.GasUsed)
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, factory, common.Hash{}))
+	child := crypto.CreateAddress(factory, 1)
+	suite.Require().Equal(0, codeSize(child))
+
+	// a code within the limit is deployed
+	factory = deployFactory(50)
+	res = send(&factory, nil)
+	suite.Require().False(res.Failed(), res.VmError)
+	child = crypto.CreateAddress(factory, 1)
+	suite.Require().Equal(common.BytesToHash(child.Bytes()), suite.app.EvmKeeper.GetState(suite.ctx, factory, common.Hash{}))
+	suite.Require().Equal(50, codeSize(child))
+}
+
+func (suite *KeeperTestSuite) TestEthereumTxBlockedAddresses() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	blockedCreator, blockedPriv := tests.NewAddrKey()
+	blockedCallee := tests.GenerateAddress()
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.BlockedContractCreators = []string{blockedCreator.Hex()}
+	evmParams.BlockedCallees = []string{blockedCallee.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+	suite.Require().NoError(err)
+	data := append(types.ERC20Contract.Bin, ctorArgs...)
+
+	// a blocked sender can't deploy
+	msg := types.NewTxContract(chainID, 0, nil, 2_000_000, nil, nil, nil, data, nil)
+	msg.From = blockedCreator.Hex()
+	suite.Require().NoError(msg.Sign(ethSigner, tests.NewSigner(blockedPriv)))
+	_, err = suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrBlockedAddress)
+
+	// an allowed sender can
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg = types.NewTxContract(chainID, nonce, nil, 2_000_000, nil, nil, nil, data, nil)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethSigner, suite.signer))
+	res, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+
+	// a blocked callee can't be called
+	msg = types.NewTx(chainID, nonce+1, &blockedCallee, nil, 100_000, nil, nil, nil, nil, nil)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethSigner, suite.signer))
+	_, err = suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrBlockedAddress)
+}
+
+func (suite *KeeperTestSuite) TestEthereumTxBlockedAddressesNested() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+	blockedCreator, blockedPriv := tests.NewAddrKey()
+
+	send := func(from common.Address, signer keyring.Signer, to *common.Address, data []byte) *types.MsgEthereumTxResponse {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, from)
+		msg := types.NewTx(chainID, nonce, to, nil, 1_000_000, nil, nil, nil, data, nil)
+		msg.From = from.Hex()
+		suite.Require().NoError(msg.Sign(ethSigner, signer))
+		res, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return res
+	}
+	deploy := func(runtime []byte) common.Address {
+		// CODECOPY the runtime code and return it
+		init := append([]byte{0x60, byte(len(runtime)), 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, byte(len(runtime)), 0x60, 0x00, 0xf3}, runtime...)
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		send(suite.address, suite.signer, nil, init)
+		return crypto.CreateAddress(suite.address, nonce)
+	}
+
+	// PUSH1 1 PUSH1 0 SSTORE STOP
+	callee := deploy(common.FromHex("0x600160005500"))
+	// CALL(GAS, callee, 0, 0, 0, 0, 0), STOP
+	proxy := deploy(common.FromHex("0x6000600060006000600073" + callee.Hex()[2:] + "5af100"))
+	// PUSH5 (PUSH1 1 PUSH1 0 RETURN) PUSH1 0 MSTORE, CREATE(0, 27, 5), PUSH1 0 SSTORE, STOP
+	factory := deploy(common.FromHex("0x6460016000f36000526005601b6000f060005500"))
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.BlockedContractCreators = []string{blockedCreator.Hex()}
+	evmParams.BlockedCallees = []string{callee.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+
+	// the lists only filter the transactions: a blocked callee can still be called by a contract
+	send(suite.address, suite.signer, &proxy, nil)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.app.EvmKeeper.GetState(suite.ctx, callee, common.Hash{}))
+
+	// and a blocked sender can still deploy a contract through a factory contract
+	send(blockedCreator, tests.NewSigner(blockedPriv), &factory, nil)
+	child := crypto.CreateAddress(factory, 1)
+	suite.Require().Equal(common.BytesToHash(child.Bytes()), suite.app.EvmKeeper.GetState(suite.ctx, factory, common.Hash{}))
+	suite.Require().Equal(types.AccountKindContract, suite.app.EvmKeeper.ClassifyAccount(suite.ctx, child))
+}
+
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	testCases := []struct {
 		name      string
@@ -115,3 +299,92 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestResetParams() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	_, err := suite.app.EvmKeeper.ResetParams(suite.ctx, &types.MsgResetParams{Authority: "foobar"})
+	suite.Require().Error(err)
+
+	params := types.DefaultParams()
+	params.EvmDenom = "aphoton"
+	params.EnableCreate = false
+	params.AllowUnprotectedTxs = true
+	params.MaxCodeSize = 1024
+	params.BlockedCallees = []string{common.BigToAddress(big.NewInt(1)).Hex()}
+	params.ChainConfig.CancunBlock = nil
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.app.EvmKeeper.ResetParams(suite.ctx, &types.MsgResetParams{Authority: authority})
+	suite.Require().NoError(err)
+
+	expParams := types.DefaultParams()
+	expParams.EvmDenom = params.EvmDenom
+	expParams.ChainConfig = params.ChainConfig
+	suite.Require().Equal(expParams, suite.app.EvmKeeper.GetParams(suite.ctx))
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeResetParams, events[0].Type)
+	var changed []string
+	for _, attr := range events[0].Attributes {
+		changed = append(changed, attr.Value)
+	}
+	suite.Require().Equal([]string{"enable_create", "allow_unprotected_txs", "max_code_size", "blocked_callees"}, changed)
+
+	// the evm denom and the chain config are reset on request
+	_, err = suite.app.EvmKeeper.ResetParams(suite.ctx, &types.MsgResetParams{
+		Authority:        authority,
+		ResetEvmDenom:    true,
+		ResetChainConfig: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), suite.app.EvmKeeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestEthereumTxTelemetry() {
+	_, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	suite.Require().NoError(err)
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(cfg, sink)
+	suite.Require().NoError(err)
+	defer func() {
+		_, _ = telemetry.New(telemetry.Config{Enabled: false})
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	}()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(10000000000000))
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.Address{0x1}, big.NewInt(10))
+
+	// count returns the number of observations of the metrics matching the name and the labels
+	count := func(values map[string]metrics.SampledValue, name string, labels ...string) int {
+		total := 0
+		for key, value := range values {
+			if !strings.HasPrefix(key, "test."+name+";") {
+				continue
+			}
+			matches := true
+			for _, label := range labels {
+				matches = matches && strings.Contains(key, ";"+label)
+			}
+			if matches {
+				total += value.Count
+			}
+		}
+		return total
+	}
+
+	data := sink.Data()
+	suite.Require().NotEmpty(data)
+	interval := data[len(data)-1]
+	suite.Require().Equal(1, count(interval.Counters, "tx.msg.ethereum_tx.status", "status=success", "execution=create"))
+	suite.Require().Equal(1, count(interval.Counters, "tx.msg.ethereum_tx.status", "status=success", "execution=call"))
+	suite.Require().Equal(0, count(interval.Counters, "tx.msg.ethereum_tx.status", "status=failed"))
+	suite.Require().Equal(1, count(interval.Samples, "tx.msg.ethereum_tx.gas_used", "execution=create"))
+	suite.Require().Equal(1, count(interval.Samples, "tx.msg.ethereum_tx.gas_used", "execution=call"))
+	suite.Require().Equal(2, count(interval.Samples, "tx.msg.ethereum_tx.latency"))
+}
@@ -16,11 +16,22 @@
 package keeper
 
 import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
 // GetParams returns the total set of evm parameters.
+//
+// The params are stored as a single blob under the params key. Until the store is migrated, they
+// are read from the legacy params subspace. The separate keys written by the consensus version 4
+// are only read by the migration to version 5, which moves them to the params key.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := k.storeService.OpenKVStore(ctx)
 	bz, _ := store.Get(types.KeyPrefixParams)
@@ -31,12 +42,48 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
+// GetEVMDenom returns the evm denom param, decoding only its field from the stored params.
+func (k Keeper) GetEVMDenom(ctx sdk.Context) string {
+	raw, stored := k.getParamsField(ctx, paramsFieldEVMDenom)
+	if !stored {
+		return k.GetParams(ctx).EvmDenom
+	}
+	return string(raw)
+}
+
+// GetEnableCall returns the enable call param, decoding only its field from the stored params.
+func (k Keeper) GetEnableCall(ctx sdk.Context) bool {
+	raw, stored := k.getParamsField(ctx, paramsFieldEnableCall)
+	if !stored {
+		return k.GetParams(ctx).EnableCall
+	}
+	return decodeParamsBool(raw)
+}
+
+// GetEnableCreate returns the enable create param, decoding only its field from the stored params.
+func (k Keeper) GetEnableCreate(ctx sdk.Context) bool {
+	raw, stored := k.getParamsField(ctx, paramsFieldEnableCreate)
+	if !stored {
+		return k.GetParams(ctx).EnableCreate
+	}
+	return decodeParamsBool(raw)
+}
+
 // SetParams sets the EVM params each in their individual key for better get performance
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
 		return err
 	}
 
+	// the forks are height dependent, so the extra EIPs can only be cross-checked against the
+	// current height
+	if eips := params.ForkEIPs(big.NewInt(ctx.BlockHeight())); len(eips) > 0 {
+		if k.strictExtraEIPs {
+			return errorsmod.Wrapf(types.ErrRedundantExtraEIP, "extra EIPs %v at height %d", eips, ctx.BlockHeight())
+		}
+		k.Logger(ctx).Warn("extra EIPs already included in an active hard fork", "eips", eips, "height", ctx.BlockHeight())
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
@@ -48,7 +95,27 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
-	return nil
+	// record the params in effect from the current height, for the historical queries
+	return store.Set(types.ParamsHistoryKey(uint64(ctx.BlockHeight())), bz)
+}
+
+// GetParamsAtHeight returns the params in effect at the given height, i.e. the last params set at or
+// before it, and whether they're known. The params changes are only recorded since the history was
+// introduced, the params in effect before the first recorded change aren't known.
+func (k Keeper) GetParamsAtHeight(ctx sdk.Context, height uint64) (types.Params, bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	iterator := store.ReverseIterator(types.KeyPrefixParamsHistory, storetypes.PrefixEndBytes(types.ParamsHistoryKey(height)))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.Params{}, false, nil
+	}
+
+	var params types.Params
+	if err := k.cdc.Unmarshal(iterator.Value(), &params); err != nil {
+		return types.Params{}, false, err
+	}
+	return params, true, nil
 }
 
 // GetLegacyParams returns param set for version before migrate
@@ -57,3 +124,53 @@ func (k Keeper) GetLegacyParams(ctx sdk.Context) types.Params {
 	k.ss.GetParamSetIfExists(ctx, &params)
 	return params
 }
+
+// protobuf field numbers of the params read on their own
+const (
+	paramsFieldEVMDenom     protowire.Number = 1
+	paramsFieldEnableCreate protowire.Number = 2
+	paramsFieldEnableCall   protowire.Number = 3
+)
+
+// getParamsField scans the stored params for the last value of a scalar field, without decoding
+// the other fields. It returns false when the params aren't stored under the params key yet. A nil
+// value means the field is omitted, i.e. it has its zero value.
+func (k Keeper) getParamsField(ctx sdk.Context, num protowire.Number) ([]byte, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.KeyPrefixParams)
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	var value []byte
+	for len(bz) > 0 {
+		fieldNum, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			panic(protowire.ParseError(n))
+		}
+		bz = bz[n:]
+
+		n = protowire.ConsumeFieldValue(fieldNum, typ, bz)
+		if n < 0 {
+			panic(protowire.ParseError(n))
+		}
+		if fieldNum == num {
+			switch typ {
+			case protowire.BytesType:
+				value, _ = protowire.ConsumeBytes(bz)
+			case protowire.VarintType:
+				value = bz[:n]
+			}
+		}
+		bz = bz[n:]
+	}
+	return value, true
+}
+
+func decodeParamsBool(raw []byte) bool {
+	if len(raw) == 0 {
+		return false
+	}
+	v, _ := protowire.ConsumeVarint(raw)
+	return v != 0
+}
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"bytes"
 	"reflect"
 
+	"cosmossdk.io/log"
+
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -98,3 +101,74 @@ func (suite *KeeperTestSuite) TestParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetParamsForkEIPs() {
+	suite.SetupTest()
+	defer suite.app.EvmKeeper.SetStrictExtraEIPs(false)
+
+	var logs bytes.Buffer
+	ctx := suite.ctx.WithLogger(log.NewLogger(&logs))
+
+	// EIP-2929 is included in Berlin, which is active: only warn
+	params := suite.app.EvmKeeper.GetParams(ctx)
+	params.ExtraEIPs = []int64{2929}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(ctx, params))
+	suite.Require().Equal(params.ExtraEIPs, suite.app.EvmKeeper.GetParams(ctx).ExtraEIPs)
+	suite.Require().Contains(logs.String(), "extra EIPs already included in an active hard fork")
+
+	// strict mode rejects them
+	suite.app.EvmKeeper.SetStrictExtraEIPs(true)
+	err := suite.app.EvmKeeper.SetParams(ctx, params)
+	suite.Require().ErrorIs(err, types.ErrRedundantExtraEIP)
+
+	params.ExtraEIPs = nil
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(ctx, params))
+}
+
+func (suite *KeeperTestSuite) TestParamsFieldGetters() {
+	testCases := []struct {
+		name     string
+		malleate func(params *types.Params)
+	}{
+		{
+			"default params",
+			func(*types.Params) {},
+		},
+		{
+			"zero values omitted from the stored params",
+			func(params *types.Params) {
+				params.EnableCall = false
+				params.EnableCreate = false
+			},
+		},
+		{
+			"custom denom",
+			func(params *types.Params) {
+				params.EvmDenom = "aphoton"
+				params.EnableCreate = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := types.DefaultParams()
+			tc.malleate(&params)
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			stored := suite.app.EvmKeeper.GetParams(suite.ctx)
+			suite.Require().Equal(stored.EvmDenom, suite.app.EvmKeeper.GetEVMDenom(suite.ctx))
+			suite.Require().Equal(stored.EnableCall, suite.app.EvmKeeper.GetEnableCall(suite.ctx))
+			suite.Require().Equal(stored.EnableCreate, suite.app.EvmKeeper.GetEnableCreate(suite.ctx))
+		})
+	}
+
+	// the params are read from the legacy storage until they're stored under the params key
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(types.KeyPrefixParams)
+	stored := suite.app.EvmKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(stored.EvmDenom, suite.app.EvmKeeper.GetEVMDenom(suite.ctx))
+	suite.Require().Equal(stored.EnableCall, suite.app.EvmKeeper.GetEnableCall(suite.ctx))
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// pendingNonces tracks the next nonces of the senders of the transactions accepted by the mempool.
+// It's written by CheckTx and read by the queries, which run concurrently.
+type pendingNonces struct {
+	mtx    sync.RWMutex
+	nonces map[common.Address]uint64
+}
+
+func newPendingNonces() *pendingNonces {
+	return &pendingNonces{nonces: make(map[common.Address]uint64)}
+}
+
+func (p *pendingNonces) get(addr common.Address) (uint64, bool) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	nonce, found := p.nonces[addr]
+	return nonce, found
+}
+
+func (p *pendingNonces) set(addr common.Address, nonce uint64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if nonce > p.nonces[addr] {
+		p.nonces[addr] = nonce
+	}
+}
+
+func (p *pendingNonces) reset() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.nonces = make(map[common.Address]uint64)
+}
@@ -0,0 +1,200 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetTxReceipt stores a compact form of the given receipt under its transaction hash. The bloom
+// isn't stored, as it can be recomputed from the logs. The receipt is kept for
+// types.HistoryRetentionBlocks blocks.
+func (k Keeper) SetTxReceipt(ctx sdk.Context, receipt *ethtypes.Receipt) error {
+	txReceipt := types.TxReceipt{
+		TxHash:            receipt.TxHash.Hex(),
+		TxIndex:           uint64(receipt.TransactionIndex),
+		Status:            receipt.Status,
+		GasUsed:           receipt.GasUsed,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		Logs:              types.NewLogsFromEth(receipt.Logs),
+	}
+	if receipt.BlockNumber != nil {
+		txReceipt.BlockNumber = receipt.BlockNumber.Uint64()
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		txReceipt.ContractAddress = receipt.ContractAddress.Hex()
+	}
+
+	bz, err := k.cdc.Marshal(&txReceipt)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.TxReceiptKey(receipt.TxHash), bz); err != nil {
+		return err
+	}
+
+	// index the tx hash by block, so the receipt can be pruned with its block
+	blockTxKey := types.BlockTxKey(uint64(ctx.BlockHeight()), uint64(receipt.TransactionIndex))
+	return store.Set(blockTxKey, receipt.TxHash.Bytes())
+}
+
+// GetTxReceipt returns the stored receipt of the transaction with the given hash and whether it
+// was found.
+func (k Keeper) GetTxReceipt(ctx sdk.Context, txHash common.Hash) (types.TxReceipt, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TxReceiptKey(txHash))
+	if err != nil {
+		return types.TxReceipt{}, false, err
+	}
+	if len(bz) == 0 {
+		return types.TxReceipt{}, false, nil
+	}
+
+	var receipt types.TxReceipt
+	if err := k.cdc.Unmarshal(bz, &receipt); err != nil {
+		return types.TxReceipt{}, false, err
+	}
+
+	return receipt, true, nil
+}
+
+// SetTx stores the encoded form of the given transaction under its hash, so it can be replayed. The
+// transaction is pruned along with its receipt, after types.HistoryRetentionBlocks blocks.
+func (k Keeper) SetTx(ctx sdk.Context, tx *ethtypes.Transaction) error {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.TxKey(tx.Hash()), bz)
+}
+
+// GetTx returns the stored transaction with the given hash and whether it was found.
+func (k Keeper) GetTx(ctx sdk.Context, txHash common.Hash) (*ethtypes.Transaction, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TxKey(txHash))
+	if err != nil {
+		return nil, false, err
+	}
+	if len(bz) == 0 {
+		return nil, false, nil
+	}
+
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(bz); err != nil {
+		return nil, false, err
+	}
+
+	return tx, true, nil
+}
+
+// PruneTxReceipts deletes the receipts of the ethereum txs executed on the given block height, along
+// with the txs themselves and the cosmos tx info recorded for them.
+func (k Keeper) PruneTxReceipts(ctx sdk.Context, height uint64) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.BlockTxsPrefix(height))
+	var keys, txHashes [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		txHashes = append(txHashes, iterator.Value())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		txHash := common.BytesToHash(txHashes[i])
+		store.Delete(types.TxReceiptKey(txHash))
+		store.Delete(types.TxKey(txHash))
+		store.Delete(types.CosmosTxInfoKey(txHash))
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+// SetCosmosTxInfo records the cosmos tx that wrapped the ethereum tx with the given hash. The info is
+// pruned along with the tx receipt, after types.HistoryRetentionBlocks blocks.
+func (k Keeper) SetCosmosTxInfo(ctx sdk.Context, ethTxHash common.Hash, info types.CosmosTxInfo) error {
+	bz, err := k.cdc.Marshal(&info)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.CosmosTxInfoKey(ethTxHash), bz)
+}
+
+// GetCosmosTxHash returns the hash, block height and index of the cosmos tx that wrapped the
+// ethereum tx with the given hash, and whether it was found.
+func (k Keeper) GetCosmosTxHash(ctx sdk.Context, ethTxHash common.Hash) (types.CosmosTxInfo, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.CosmosTxInfoKey(ethTxHash))
+	if err != nil {
+		return types.CosmosTxInfo{}, false, err
+	}
+	if len(bz) == 0 {
+		return types.CosmosTxInfo{}, false, nil
+	}
+
+	var info types.CosmosTxInfo
+	if err := k.cdc.Unmarshal(bz, &info); err != nil {
+		return types.CosmosTxInfo{}, false, err
+	}
+
+	return info, true, nil
+}
+
+// SetContractCreation records the transaction that created the contract with the given address.
+func (k Keeper) SetContractCreation(ctx sdk.Context, contract common.Address, creation types.ContractCreation) error {
+	bz, err := k.cdc.Marshal(&creation)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.ContractCreationKey(contract), bz)
+}
+
+// GetContractCreation returns the creator, the hash and the block height of the transaction that
+// created the contract with the given address, and whether it was found.
+func (k Keeper) GetContractCreation(ctx sdk.Context, contract common.Address) (types.ContractCreation, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ContractCreationKey(contract))
+	if err != nil {
+		return types.ContractCreation{}, false, err
+	}
+	if len(bz) == 0 {
+		return types.ContractCreation{}, false, nil
+	}
+
+	var creation types.ContractCreation
+	if err := k.cdc.Unmarshal(bz, &creation); err != nil {
+		return types.ContractCreation{}, false, err
+	}
+
+	return creation, true, nil
+}
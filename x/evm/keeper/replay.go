@@ -0,0 +1,193 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// ReplayTx re-executes the historical transaction with the given hash, reconstructed from its stored
+// tx data, and returns the result of the tracer set by the trace config, which defaults to the
+// struct logger.
+//
+// The transaction's block is loaded from the historical store at the state it executed against, and
+// the transactions executed before it in the same block are applied first, along with their ante
+// handler fee deduction and nonce increment. Nothing is committed to the state. The transaction
+// must still be within the types.HistoryRetentionBlocks blocks kept by the context's state.
+func (k *Keeper) ReplayTx(ctx sdk.Context, txHash common.Hash, traceConfig *types.TraceConfig) (json.RawMessage, error) {
+	if k.historicalStore == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrNotSupported, "no historical store to replay the tx from")
+	}
+
+	receipt, found, err := k.GetTxReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTxReceiptNotFound, "tx %s", txHash.Hex())
+	}
+
+	// load the txs of the block up to the replayed one, before switching to the block's state
+	txs, err := k.getBlockTxs(ctx, receipt.BlockNumber, receipt.TxIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	replayCtx, err := k.historicalContext(ctx, receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := k.EVMConfig(replayCtx, GetProposerAddress(replayCtx, nil), k.ChainID())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(replayCtx.BlockHeight()))
+
+	predecessors, tx := txs[:len(txs)-1], txs[len(txs)-1]
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(replayCtx.HeaderHash()))
+	for i, predecessor := range predecessors {
+		msg, err := predecessor.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to return predecessor %s as core message", predecessor.Hash())
+		}
+		if err := k.replayAnte(replayCtx, msg, cfg); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to replay the ante handler of predecessor %s", predecessor.Hash())
+		}
+
+		txConfig.TxHash = predecessor.Hash()
+		txConfig.TxIndex = uint(i)
+		rsp, err := k.ApplyMessageWithConfig(replayCtx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to apply predecessor %s", predecessor.Hash())
+		}
+		if err := k.RefundGas(replayCtx, msg, msg.Gas()-rsp.GasUsed, cfg.Params.EvmDenom); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to refund the leftover gas of predecessor %s", predecessor.Hash())
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
+	}
+	if err := k.replayAnte(replayCtx, msg, cfg); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to replay the ante handler")
+	}
+	txConfig.TxHash = tx.Hash()
+	txConfig.TxIndex = uint(len(predecessors))
+
+	var tracerConfig json.RawMessage
+	if traceConfig != nil && traceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(traceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	result, _, err := k.traceTx(replayCtx, cfg, txConfig, signer, tx, traceConfig, false, tracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// getBlockTxs returns the stored txs executed on the given block height, up to and including the one
+// at the given index.
+func (k Keeper) getBlockTxs(ctx sdk.Context, height, lastIndex uint64) ([]*ethtypes.Transaction, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.BlockTxsPrefix(height))
+	defer iterator.Close()
+
+	var txs []*ethtypes.Transaction
+	for ; iterator.Valid() && uint64(len(txs)) <= lastIndex; iterator.Next() {
+		txHash := common.BytesToHash(iterator.Value())
+		tx, found, err := k.GetTx(ctx, txHash)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "tx %s of block %d", txHash.Hex(), height)
+		}
+		txs = append(txs, tx)
+	}
+
+	if uint64(len(txs)) != lastIndex+1 {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "tx %d of block %d", lastIndex, height)
+	}
+
+	return txs, nil
+}
+
+// historicalContext returns a context for executing the block at the given height, branched from the
+// historical store at the state the block executed against, with the block header recorded by the
+// staking module.
+func (k Keeper) historicalContext(ctx sdk.Context, height uint64) (sdk.Context, error) {
+	version, err := ethermint.SafeInt64(height)
+	if err != nil {
+		return ctx, err
+	}
+
+	histInfo, err := k.stakingKeeper.GetHistoricalInfo(ctx, version)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "failed to get the header of block %d", height)
+	}
+	headerHash := k.GetHashFn(ctx)(height)
+
+	ms, err := k.historicalStore.CacheMultiStoreWithVersion(version - 1)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "failed to load the state of block %d", height)
+	}
+
+	return ctx.
+		WithMultiStore(ms).
+		WithBlockHeader(histInfo.Header).
+		WithHeaderHash(headerHash.Bytes()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()), nil
+}
+
+// replayAnte reproduces the state changes of the ante handler for the given message: the deduction of
+// the fees from the sender's balance, and the increment of its nonce, which is done during the
+// execution for contract creations.
+func (k *Keeper) replayAnte(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig) error {
+	fee := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(msg.Gas()))
+	fees := sdk.NewCoins(sdk.NewCoin(cfg.Params.EvmDenom, sdkmath.NewIntFromBigInt(fee)))
+	if err := k.DeductTxCostsFromUserBalance(ctx, fees, msg.From()); err != nil {
+		return err
+	}
+
+	if msg.To() == nil {
+		return nil
+	}
+
+	acct := k.GetAccountOrEmpty(ctx, msg.From())
+	acct.Nonce++
+	return k.SetAccount(ctx, msg.From(), acct)
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestReplayTx() {
+	suite.SetupTest()
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	// the balance returned by the second tx depends on the transfer of the first one
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	amount := big.NewInt(1000)
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, amount)
+
+	balanceOfData, err := types.ERC20Contract.ABI.Pack("balanceOf", recipient)
+	suite.Require().NoError(err)
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := types.NewTx(chainID, nonce, &contractAddr, nil, 100_000, nil, nil, nil, balanceOfData, nil)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+	rsp, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+
+	height := suite.ctx.BlockHeight()
+	suite.Commit()
+
+	// replay the tx once its block is committed, from a later state
+	suite.Commit()
+	queryCtx, err := suite.app.CreateQueryContext(suite.app.LastBlockHeight(), false)
+	suite.Require().NoError(err)
+
+	trace, err := suite.app.EvmKeeper.ReplayTx(queryCtx, msg.AsTransaction().Hash(), nil)
+	suite.Require().NoError(err)
+
+	var result ethlogger.ExecutionResult
+	suite.Require().NoError(json.Unmarshal(trace, &result))
+	suite.Require().False(result.Failed)
+	suite.Require().NotEmpty(result.StructLogs)
+	suite.Require().Equal(rsp.GasUsed, result.Gas)
+	suite.Require().Equal(hexutil.Encode(rsp.Ret), "0x"+result.ReturnValue)
+	suite.Require().Equal(amount, new(big.Int).SetBytes(rsp.Ret))
+
+	// the replay isn't committed
+	suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(queryCtx, suite.address))
+
+	receipt, found, err := suite.app.EvmKeeper.GetTxReceipt(queryCtx, msg.AsTransaction().Hash())
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(height), receipt.BlockNumber)
+
+	_, err = suite.app.EvmKeeper.ReplayTx(queryCtx, common.Hash{}, nil)
+	suite.Require().ErrorIs(err, types.ErrTxReceiptNotFound)
+}
@@ -53,6 +53,13 @@ func (k *Keeper) NewEVM(
 	tracer vm.EVMLogger,
 	stateDB vm.StateDB,
 ) evm.EVM {
+	baseFee := cfg.BaseFee
+	if baseFee == nil {
+		// the BASEFEE opcode can be enabled through the extra EIPs before London, when blocks have no base
+		// fee, so it reads zero instead of dereferencing a nil base fee.
+		baseFee = big.NewInt(0)
+	}
+
 	blockCtx := vm.BlockContext{
 		CanTransfer: core.CanTransfer,
 		Transfer:    core.Transfer,
@@ -62,9 +69,10 @@ func (k *Keeper) NewEVM(
 		BlockNumber: big.NewInt(ctx.BlockHeight()),
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
-		BaseFee:     cfg.BaseFee,
+		BaseFee:     baseFee,
 		Random:      nil, // not supported
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := core.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -179,7 +187,8 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	}
 
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	stateDB := statedb.New(tmpCtx, k, txConfig)
+	res, err := k.applyMessageWithStateDB(tmpCtx, msg, nil, true, cfg, stateDB)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
@@ -245,10 +254,41 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
+	// NOTE: use the response logs, as they're cleared when the post processing hooks fail
+	receipt.Logs = types.LogsToEthereum(res.Logs)
+	setLogPositions(receipt.Logs, uint64(ctx.BlockHeight()), txConfig)
+	res.Logs = types.NewLogsFromEth(receipt.Logs)
+
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, receipt.Bloom.Big())
 		k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(receipt.Logs)))
+
+		if err = k.SetLogs(ctx, receipt.Logs); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to store tx logs")
+		}
+	}
+
+	if res.Failed() {
+		receipt.Status = ethtypes.ReceiptStatusFailed
+	} else {
+		// the contracts created by CREATE2 are only known from the state changes
+		creation := types.ContractCreation{
+			Creator:     msg.From().Hex(),
+			TxHash:      txConfig.TxHash.Hex(),
+			BlockHeight: uint64(ctx.BlockHeight()),
+		}
+		for _, contract := range stateDB.CreatedContracts() {
+			if err := k.SetContractCreation(ctx, contract, creation); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to store contract creation")
+			}
+		}
+	}
+	if err = k.SetTxReceipt(ctx, receipt); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store tx receipt")
+	}
+	if err = k.SetTx(ctx, ethTx); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store tx")
 	}
 
 	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
@@ -263,6 +303,19 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	return res, nil
 }
 
+// setLogPositions sets the block and transaction position fields of the logs emitted by a
+// transaction, numbering them from the first log index available in the block. The post processing
+// hooks may alter the logs, so the fields set by the StateDB can't be relied on.
+func setLogPositions(logs []*ethtypes.Log, height uint64, txConfig statedb.TxConfig) {
+	for i, log := range logs {
+		log.BlockNumber = height
+		log.BlockHash = txConfig.BlockHash
+		log.TxHash = txConfig.TxHash
+		log.TxIndex = txConfig.TxIndex
+		log.Index = txConfig.LogIndex + uint(i)
+	}
+}
+
 // ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
 func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*types.MsgEthereumTxResponse, error) {
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
@@ -318,6 +371,18 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	commit bool,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	return k.applyMessageWithStateDB(ctx, msg, tracer, commit, cfg, statedb.New(ctx, k, txConfig))
+}
+
+// applyMessageWithStateDB is ApplyMessageWithConfig executing the message against the given StateDB,
+// which can be inspected afterwards.
+func (k *Keeper) applyMessageWithStateDB(ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	stateDB *statedb.StateDB,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
@@ -331,7 +396,33 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
-	stateDB := statedb.New(ctx, k, txConfig)
+	// return error if the sender can't deploy contracts or the callee can't be called, like the
+	// EnableCreate and EnableCall params only the transaction itself is checked, not the nested
+	// calls and creations
+	if msg.To() == nil && cfg.Params.IsBlockedContractCreator(msg.From()) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "contract creator %s", msg.From())
+	} else if msg.To() != nil && cfg.Params.IsBlockedCallee(*msg.To()) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "callee %s", msg.To())
+	}
+
+	// EIP-3860: the init code size of contract creations is limited once Shanghai is active. The
+	// limit isn't enforced on params stored before it existed.
+	if msg.To() == nil && cfg.ChainConfig.IsShanghai(big.NewInt(ctx.BlockHeight())) &&
+		cfg.Params.MaxInitCodeSize > 0 && uint64(len(msg.Data())) > cfg.Params.MaxInitCodeSize {
+		return nil, errorsmod.Wrapf(types.ErrMaxInitCodeSizeExceeded, "code size %d, limit %d", len(msg.Data()), cfg.Params.MaxInitCodeSize)
+	}
+
+	if cfg.Overrides != nil {
+		if commit {
+			return nil, errorsmod.Wrap(types.ErrInvalidState, "state overrides can't be committed")
+		}
+		if err := cfg.Overrides.Apply(stateDB); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidState, err.Error())
+		}
+	}
+	if cfg.ChainConfig.IsCancun(big.NewInt(ctx.BlockHeight())) {
+		stateDB.EnableEIP6780()
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -373,10 +464,24 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		// - reset sender's nonce to msg.Nonce() before calling evm.
 		// - increase sender's nonce by one no matter the result.
 		stateDB.SetNonce(sender.Address(), msg.Nonce())
+		snapshot := stateDB.Snapshot()
 		ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
+		if vmErr == nil {
+			if vmErr = checkCreatedCodeSize(stateDB, cfg.Params.MaxCodeSize); vmErr != nil {
+				stateDB.RevertToSnapshot(snapshot)
+				leftoverGas = 0
+			}
+		}
 		stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
 	} else {
+		snapshot := stateDB.Snapshot()
 		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
+		if vmErr == nil {
+			if vmErr = checkCreatedCodeSize(stateDB, cfg.Params.MaxCodeSize); vmErr != nil {
+				stateDB.RevertToSnapshot(snapshot)
+				leftoverGas = 0
+			}
+		}
 	}
 
 	refundQuotient := params.RefundQuotient
@@ -427,6 +532,23 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		VmError: vmError,
 		Ret:     ret,
 		Logs:    types.NewLogsFromEth(stateDB.Logs()),
-		Hash:    txConfig.TxHash.Hex(),
+		Hash:    stateDB.TxConfig().TxHash.Hex(),
 	}, nil
 }
+
+// checkCreatedCodeSize checks the code of every contract created by the transaction, including the
+// ones deployed by a factory contract with CREATE/CREATE2, against the MaxCodeSize param. The EVM
+// only enforces the EIP-170 limit, a transaction depositing a larger code than the (lower) limit set
+// in params is reverted and consumes all its gas. It's not enforced on params stored before it
+// existed.
+func checkCreatedCodeSize(stateDB *statedb.StateDB, maxCodeSize uint64) error {
+	if maxCodeSize == 0 {
+		return nil
+	}
+	for _, contract := range stateDB.CreatedContracts() {
+		if size := stateDB.GetCodeSize(contract); uint64(size) > maxCodeSize {
+			return errorsmod.Wrapf(types.ErrMaxCodeSizeExceeded, "contract %s, code size %d, limit %d", contract, size, maxCodeSize)
+		}
+	}
+	return nil
+}
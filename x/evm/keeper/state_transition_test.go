@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -15,6 +17,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/keeper"
@@ -663,6 +667,220 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestShanghaiPush0Activation() {
+	// PUSH0 PUSH0 RETURN: deploys an empty contract, but only if EIP-3855 (PUSH0) is active
+	initCode := []byte{0x5f, 0x5f, 0xf3}
+
+	height := sdkmath.NewInt(suite.ctx.BlockHeight())
+	nextHeight := height.AddRaw(1)
+
+	testCases := []struct {
+		name          string
+		shanghaiBlock *sdkmath.Int
+		expInvalid    bool
+	}{
+		{"shanghai block at current height", &height, false},
+		{"shanghai block after current height", &nextHeight, true},
+		{"shanghai never active", nil, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+			keeperParams.ChainConfig.ShanghaiBlock = tc.shanghaiBlock
+			keeperParams.ChainConfig.CancunBlock = nil
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, keeperParams))
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+			suite.Require().Equal(!tc.expInvalid, config.ChainConfig.IsShanghai(big.NewInt(suite.ctx.BlockHeight())))
+
+			msg := ethtypes.NewMessage(
+				suite.address,
+				nil,
+				suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				big.NewInt(0),
+				100_000,
+				big.NewInt(0),
+				big.NewInt(0),
+				big.NewInt(0),
+				initCode,
+				nil,
+				true,
+			)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+			if tc.expInvalid {
+				suite.Require().Contains(res.VmError, "invalid opcode")
+				return
+			}
+			suite.Require().Empty(res.VmError)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestShanghaiInitCodeSizeLimit() {
+	// STOP followed by padding: deploys an empty contract
+	initCode := make([]byte, 33)
+
+	height := sdkmath.NewInt(suite.ctx.BlockHeight())
+	nextHeight := height.AddRaw(1)
+
+	testCases := []struct {
+		name          string
+		shanghaiBlock *sdkmath.Int
+		expRejected   bool
+	}{
+		{"shanghai block at current height", &height, true},
+		{"shanghai block after current height", &nextHeight, false},
+		{"shanghai never active", nil, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+			keeperParams.ChainConfig.ShanghaiBlock = tc.shanghaiBlock
+			keeperParams.ChainConfig.CancunBlock = nil
+			keeperParams.MaxInitCodeSize = 32
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, keeperParams))
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+
+			msg := ethtypes.NewMessage(
+				suite.address,
+				nil,
+				suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				big.NewInt(0),
+				100_000,
+				big.NewInt(0),
+				big.NewInt(0),
+				big.NewInt(0),
+				initCode,
+				nil,
+				true,
+			)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			if tc.expRejected {
+				suite.Require().ErrorIs(err, types.ErrMaxInitCodeSizeExceeded)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSelfDestruct() {
+	const (
+		opCaller  = 0x33
+		opAddress = 0x30
+	)
+	contractBalance := big.NewInt(1000)
+	height := sdkmath.NewInt(suite.ctx.BlockHeight())
+
+	testCases := []struct {
+		name          string
+		cancunBlock   *sdkmath.Int
+		deployFirst   bool
+		beneficiary   byte
+		expDestructed bool
+		expBalance    *big.Int
+	}{
+		{"destructed in the creation tx, cancun", &height, false, opCaller, true, nil},
+		{"existing contract, cancun", &height, true, opCaller, false, big.NewInt(0)},
+		{"existing contract sending to itself, cancun", &height, true, opAddress, false, contractBalance},
+		{"destructed in the creation tx, pre-cancun", nil, false, opCaller, true, nil},
+		{"existing contract, pre-cancun", nil, true, opCaller, true, nil},
+		{"existing contract sending to itself, pre-cancun", nil, true, opAddress, true, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// <beneficiary> SELFDESTRUCT: sends the balance to the beneficiary and destructs the contract
+			selfDestructCode := []byte{tc.beneficiary, 0xff}
+			// deploys selfDestructCode as runtime code
+			deployCode := []byte{0x61, tc.beneficiary, 0xff, 0x60, 0x00, 0x52, 0x60, 0x02, 0x60, 0x1e, 0xf3}
+
+			keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+			keeperParams.ChainConfig.CancunBlock = tc.cancunBlock
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, keeperParams))
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			apply := func(to *common.Address, data []byte) {
+				msg := ethtypes.NewMessage(
+					suite.address,
+					to,
+					suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+					big.NewInt(0),
+					100_000,
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(0),
+					data,
+					nil,
+					true,
+				)
+				res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.VmError)
+			}
+
+			contractAddr := crypto.CreateAddress(suite.address, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+			if tc.deployFirst {
+				apply(nil, deployCode)
+				suite.Require().Equal(selfDestructCode, suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr).CodeHash)))
+				suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, contractAddr, contractBalance))
+			}
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			if tc.deployFirst {
+				apply(&contractAddr, nil)
+			} else {
+				apply(nil, selfDestructCode)
+			}
+
+			var events []sdk.Event
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeSelfDestruct {
+					events = append(events, event)
+				}
+			}
+
+			if !tc.expDestructed {
+				suite.Require().Empty(suite.app.EvmKeeper.GetDestructedAccounts(suite.ctx))
+				suite.Require().Empty(events)
+				suite.Require().NotNil(suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr))
+				suite.Require().Equal(tc.expBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, contractAddr))
+				return
+			}
+
+			suite.Require().Equal([]common.Address{contractAddr}, suite.app.EvmKeeper.GetDestructedAccounts(suite.ctx))
+			suite.Require().Len(events, 1)
+			attr, ok := events[0].GetAttribute(types.AttributeKeyContractAddress)
+			suite.Require().True(ok)
+			suite.Require().Equal(contractAddr.Hex(), attr.Value)
+			suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, cfg *params.ChainConfig, gasPrice *big.Int) (core.Message, error) {
 	ethMsg, err := suite.createContractMsgTx(nonce, signer, cfg, gasPrice)
 	if err != nil {
@@ -689,6 +907,124 @@ func (suite *KeeperTestSuite) createContractMsgTx(nonce uint64, signer ethtypes.
 	return ethMsg, ethMsg.Sign(signer, suite.signer)
 }
 
+// callGasTracer records the gas available to the call opcodes and the gas they forward.
+type callGasTracer struct {
+	pending *forwardedCall
+	calls   []forwardedCall
+}
+
+type forwardedCall struct {
+	depth     int
+	gas       uint64 // gas available before executing the opcode
+	cost      uint64 // cost of the opcode, including the forwarded gas
+	forwarded uint64
+}
+
+func (t *callGasTracer) CaptureTxStart(uint64) {}
+
+func (t *callGasTracer) CaptureTxEnd(uint64) {}
+
+func (t *callGasTracer) CaptureStart(*vm.EVM, common.Address, common.Address, bool, []byte, uint64, *big.Int) {
+}
+
+func (t *callGasTracer) CaptureEnd([]byte, uint64, time.Duration, error) {}
+
+func (t *callGasTracer) CaptureState(_ uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, _ []byte, depth int, _ error) {
+	switch op {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		t.pending = &forwardedCall{depth: depth, gas: gas, cost: cost}
+	}
+}
+
+func (t *callGasTracer) CaptureEnter(_ vm.OpCode, _ common.Address, _ common.Address, _ []byte, gas uint64, _ *big.Int) {
+	if t.pending != nil {
+		t.pending.forwarded = gas
+		t.calls = append(t.calls, *t.pending)
+		t.pending = nil
+	}
+}
+
+func (t *callGasTracer) CaptureExit([]byte, uint64, error) {}
+
+func (t *callGasTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+func (suite *KeeperTestSuite) TestCallGasForwarding() {
+	// CALL(GAS, ADDRESS, 0, 0, 0, 0, 0): calls itself recursively with all the gas available
+	recursiveCode := common.FromHex("0x60006000600060006000305af100")
+
+	testCases := []struct {
+		name      string
+		extraEIPs []int64
+	}{
+		{"no extra eips", nil},
+		{"all the extra eips", types.AvailableExtraEIPs},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+			keeperParams.ExtraEIPs = tc.extraEIPs
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, keeperParams))
+
+			messageCall := suite.DeployTestMessageCall(suite.T())
+			messageCallInput, err := types.TestMessageCall.ABI.Pack("benchmarkMessageCall", big.NewInt(5))
+			suite.Require().NoError(err)
+
+			recursive := tests.GenerateAddress()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(recursive, recursiveCode)
+			suite.Require().NoError(vmdb.Commit())
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			for _, call := range []struct {
+				to       common.Address
+				input    []byte
+				minDepth int
+			}{
+				{messageCall, messageCallInput, 1},
+				{recursive, nil, 100},
+			} {
+				msg := ethtypes.NewMessage(
+					suite.address,
+					&call.to,
+					suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+					big.NewInt(0),
+					1_000_000,
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(0),
+					call.input,
+					nil,
+					true,
+				)
+				tracer := &callGasTracer{}
+				_, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, tracer, false, config, txConfig)
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(tracer.calls)
+
+				maxDepth := 0
+				for _, c := range tracer.calls {
+					// EIP-150: at most 63/64 of the gas left after paying for the call is forwarded,
+					// 1/64 is retained by the caller at each depth
+					available := c.gas - (c.cost - c.forwarded)
+					suite.Require().Equal(available-available/64, c.forwarded, "depth %d", c.depth)
+					if c.depth > maxDepth {
+						maxDepth = c.depth
+					}
+				}
+				suite.Require().GreaterOrEqual(maxDepth, call.minDepth)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetProposerAddress() {
 	var a sdk.ConsAddress
 	address := sdk.ConsAddress(suite.address.Bytes())
@@ -16,8 +16,10 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -61,6 +63,20 @@ func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash)
 	return common.BytesToHash(value)
 }
 
+// GetStateWithExists loads contract state from database like GetState, it also returns whether the
+// slot exists in the store, to tell apart the slots that were never written or deleted.
+func (k *Keeper) GetStateWithExists(ctx sdk.Context, addr common.Address, key common.Hash) (common.Hash, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.AddressStoragePrefix(addr))
+
+	value := prefixStore.Get(key.Bytes())
+	if value == nil {
+		return common.Hash{}, false
+	}
+
+	return common.BytesToHash(value), true
+}
+
 // GetCode loads contract code from database, implements `statedb.Keeper` interface.
 func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -68,6 +84,21 @@ func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 	return prefixStore.Get(codeHash.Bytes())
 }
 
+// GetCodeSize returns the size of the contract code with the given hash, without loading the code
+// when its size is recorded. Code stored before sizes were recorded falls back to loading it.
+func (k *Keeper) GetCodeSize(ctx sdk.Context, codeHash common.Hash) int {
+	if codeHash == (common.Hash{}) || bytes.Equal(codeHash.Bytes(), types.EmptyCodeHash) {
+		return 0
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if bz := store.Get(types.CodeSizeKey(codeHash.Bytes())); len(bz) > 0 {
+		return int(sdk.BigEndianToUint64(bz))
+	}
+
+	return len(k.GetCode(ctx, codeHash))
+}
+
 // ForEachStorage iterate contract storage, callback return false to break early
 func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -91,14 +122,14 @@ func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(ke
 func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 
-	params := k.GetParams(ctx)
-	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, params.EvmDenom)
+	evmDenom := k.GetEVMDenom(ctx)
+	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, evmDenom)
 	balance := coin.Amount.BigInt()
 	delta := new(big.Int).Sub(amount, balance)
 	switch delta.Sign() {
 	case 1:
 		// mint
-		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(delta)))
+		coins := sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(delta)))
 		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 			return err
 		}
@@ -107,7 +138,7 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.In
 		}
 	case -1:
 		// burn
-		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(new(big.Int).Neg(delta))))
+		coins := sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(new(big.Int).Neg(delta))))
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, cosmosAddr, types.ModuleName, coins); err != nil {
 			return err
 		}
@@ -143,6 +174,16 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 
 	k.accountKeeper.SetAccount(ctx, acct)
 
+	if types.IsContractCodeHash(codeHash) {
+		k.SetContract(ctx, addr)
+	} else {
+		// the code can be reset, e.g. when a precompile is disabled
+		store := k.storeService.OpenKVStore(ctx)
+		if err := store.Delete(types.ContractKey(addr)); err != nil {
+			return err
+		}
+	}
+
 	if err := k.SetBalance(ctx, addr, account.Balance); err != nil {
 		return err
 	}
@@ -157,12 +198,24 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 	return nil
 }
 
+// SetContract indexes the given address as a contract account, so the contracts can be listed without
+// iterating over all the accounts. A contract keeps its code until it's deleted, which removes it
+// from the index.
+func (k *Keeper) SetContract(ctx sdk.Context, addr common.Address) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := types.ContractKey(addr)
+	if !store.Has(key) {
+		store.Set(key, []byte{})
+	}
+}
+
 // SetState update contract storage, delete if value is empty.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	prefixStore := prefix.NewStore(store, types.AddressStoragePrefix(addr))
 	action := "updated"
-	if len(value) == 0 {
+	// writing a zero value deletes the slot, as in the EVM
+	if len(value) == 0 || common.BytesToHash(value) == (common.Hash{}) {
 		prefixStore.Delete(key.Bytes())
 		action = "deleted"
 	} else {
@@ -175,18 +228,43 @@ func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash,
 	)
 }
 
+// SetStates sets multiple storage slots of the contract at once, opening the store only once. The
+// slots with a zero value are deleted, as in the EVM.
+func (k *Keeper) SetStates(ctx sdk.Context, addr common.Address, states []types.State) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, types.AddressStoragePrefix(addr))
+
+	for _, state := range states {
+		key := common.HexToHash(state.Key)
+		value := common.HexToHash(state.Value)
+		if value == (common.Hash{}) {
+			prefixStore.Delete(key.Bytes())
+		} else {
+			prefixStore.Set(key.Bytes(), value.Bytes())
+		}
+	}
+
+	k.Logger(ctx).Debug(
+		"states updated",
+		"ethereum-address", addr.Hex(),
+		"count", len(states),
+	)
+}
+
 // SetCode set contract code, delete if code is empty.
 func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	prefixStore := prefix.NewStore(store, types.KeyPrefixCode)
 
-	// store or delete code
+	// store or delete code, along with its size
 	action := "updated"
 	if len(code) == 0 {
 		prefixStore.Delete(codeHash)
+		store.Delete(types.CodeSizeKey(codeHash))
 		action = "deleted"
 	} else {
 		prefixStore.Set(codeHash, code)
+		store.Set(types.CodeSizeKey(codeHash), sdk.Uint64ToBigEndian(uint64(len(code))))
 	}
 	k.Logger(ctx).Debug(
 		fmt.Sprintf("code %s", action),
@@ -199,10 +277,13 @@ func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 // - remove code
 // - remove states
 // - remove auth account
+// - record the account as destructed by the current tx and emit a selfdestruct event
 func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 	acct := k.accountKeeper.GetAccount(ctx, cosmosAddr)
 	if acct == nil {
+		// the account was created and destructed by the same tx
+		k.destructed(ctx, addr)
 		return nil
 	}
 
@@ -225,6 +306,12 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 
 	// remove auth account
 	k.accountKeeper.RemoveAccount(ctx, acct)
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.ContractKey(addr)); err != nil {
+		return err
+	}
+
+	k.destructed(ctx, addr)
 
 	k.Logger(ctx).Debug(
 		"account suicided",
@@ -234,3 +321,15 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 
 	return nil
 }
+
+// destructed records the account as destructed by the current tx and emits the selfdestruct event.
+func (k *Keeper) destructed(ctx sdk.Context, addr common.Address) {
+	k.SetDestructedAccountTransient(ctx, addr)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSelfDestruct,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, addr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyTxIndex, strconv.FormatUint(k.GetTxIndexTransient(ctx), 10)),
+		),
+	)
+}
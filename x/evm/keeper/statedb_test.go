@@ -362,6 +362,28 @@ func (suite *KeeperTestSuite) TestKeeperSetCode() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGetCodeSize() {
+	code := []byte("this is the code")
+	codeHash := crypto.Keccak256Hash(code)
+
+	suite.app.EvmKeeper.SetCode(suite.ctx, codeHash.Bytes(), code)
+	suite.Require().Equal(len(code), suite.app.EvmKeeper.GetCodeSize(suite.ctx, codeHash))
+	suite.Require().Equal(len(suite.app.EvmKeeper.GetCode(suite.ctx, codeHash)), suite.app.EvmKeeper.GetCodeSize(suite.ctx, codeHash))
+
+	// code stored without its size falls back to loading the code
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(types.CodeSizeKey(codeHash.Bytes()))
+	suite.Require().Equal(len(code), suite.app.EvmKeeper.GetCodeSize(suite.ctx, codeHash))
+
+	// deleted code has no size
+	suite.app.EvmKeeper.SetCode(suite.ctx, codeHash.Bytes(), nil)
+	suite.Require().Equal(0, suite.app.EvmKeeper.GetCodeSize(suite.ctx, codeHash))
+	suite.Require().False(store.Has(types.CodeSizeKey(codeHash.Bytes())))
+
+	suite.Require().Equal(0, suite.app.EvmKeeper.GetCodeSize(suite.ctx, common.BytesToHash(types.EmptyCodeHash)))
+	suite.Require().Equal(0, suite.app.EvmKeeper.GetCodeSize(suite.ctx, common.Hash{}))
+}
+
 func (suite *KeeperTestSuite) TestRefund() {
 	testCases := []struct {
 		name      string
@@ -428,6 +450,82 @@ func (suite *KeeperTestSuite) TestState() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSetStateZeroValue() {
+	suite.SetupTest()
+
+	key := common.BytesToHash([]byte("key"))
+	value := common.BytesToHash([]byte("value"))
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.AddressStoragePrefix(suite.address))
+
+	suite.app.EvmKeeper.SetState(suite.ctx, suite.address, key, value.Bytes())
+	suite.Require().Equal(value, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key))
+	suite.Require().True(store.Has(key.Bytes()))
+
+	suite.app.EvmKeeper.SetState(suite.ctx, suite.address, key, common.Hash{}.Bytes())
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key))
+	suite.Require().False(store.Has(key.Bytes()))
+}
+
+func (suite *KeeperTestSuite) TestGetStateWithExists() {
+	suite.SetupTest()
+
+	key := common.BytesToHash([]byte("key"))
+	zeroedKey := common.BytesToHash([]byte("zeroed"))
+	value := common.BytesToHash([]byte("value"))
+
+	suite.app.EvmKeeper.SetState(suite.ctx, suite.address, key, value.Bytes())
+	suite.app.EvmKeeper.SetState(suite.ctx, suite.address, zeroedKey, value.Bytes())
+	suite.app.EvmKeeper.SetState(suite.ctx, suite.address, zeroedKey, common.Hash{}.Bytes())
+
+	testCases := []struct {
+		name      string
+		key       common.Hash
+		expValue  common.Hash
+		expExists bool
+	}{
+		{"never written slot", common.BytesToHash([]byte("unset")), common.Hash{}, false},
+		{"written then zeroed slot", zeroedKey, common.Hash{}, false},
+		{"written slot", key, value, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			value, exists := suite.app.EvmKeeper.GetStateWithExists(suite.ctx, suite.address, tc.key)
+			suite.Require().Equal(tc.expValue, value)
+			suite.Require().Equal(tc.expExists, exists)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetStates() {
+	suite.SetupTest()
+
+	key1 := common.BytesToHash([]byte("key1"))
+	key2 := common.BytesToHash([]byte("key2"))
+	zeroKey := common.BytesToHash([]byte("zero"))
+	value1 := common.BytesToHash([]byte("value1"))
+	value2 := common.BytesToHash([]byte("value2"))
+
+	// the zero slot is previously set, so it's deleted
+	suite.app.EvmKeeper.SetState(suite.ctx, suite.address, zeroKey, value1.Bytes())
+
+	suite.app.EvmKeeper.SetStates(suite.ctx, suite.address, []types.State{
+		{Key: key1.Hex(), Value: value1.Hex()},
+		{Key: zeroKey.Hex(), Value: common.Hash{}.Hex()},
+		{Key: key2.Hex(), Value: value2.Hex()},
+	})
+
+	suite.Require().Equal(value1, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key1))
+	suite.Require().Equal(value2, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key2))
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, zeroKey))
+
+	storage := suite.app.EvmKeeper.GetAccountStorage(suite.ctx, suite.address)
+	suite.Require().Len(storage, 2)
+	for _, state := range storage {
+		suite.Require().NotEqual(zeroKey.Hex(), state.Key)
+	}
+}
+
 func (suite *KeeperTestSuite) TestCommittedState() {
 	key := common.BytesToHash([]byte("key"))
 	value1 := common.BytesToHash([]byte("value1"))
@@ -154,3 +154,61 @@ func CheckSenderBalance(
 	}
 	return nil
 }
+
+// CheckBaseFee rejects the transaction if its fee cap, which is the gas price of the legacy and
+// access list transactions, is below the base fee of the current block. No transaction is
+// rejected when there is no base fee, before the London hardfork or with the fee market disabled.
+func (k Keeper) CheckBaseFee(ctx sdk.Context, msg *types.MsgEthereumTx) error {
+	ethCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.eip155ChainID)
+	baseFee := k.GetBaseFee(ctx, ethCfg)
+	if baseFee == nil || baseFee.Sign() == 0 {
+		return nil
+	}
+
+	txData, err := types.UnpackTxData(msg.Data)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
+	if feeCap := txData.GetGasFeeCap(); feeCap.Cmp(baseFee) < 0 {
+		return errorsmod.Wrapf(types.ErrGasPriceTooLow, "max fee per gas less than block base fee (%s < %s)", feeCap, baseFee)
+	}
+	return nil
+}
+
+// CheckSenderTxLimit counts a transaction of the sender in the current block and rejects it if the
+// sender already included limit transactions in the block. A zero limit disables the check. The
+// counters live in the transient store, so they start over on every block.
+func (k Keeper) CheckSenderTxLimit(ctx sdk.Context, sender common.Address, limit uint64) error {
+	if limit == 0 {
+		return nil
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	key := types.TransientSenderTxCountKey(sender)
+
+	var count uint64
+	if bz := store.Get(key); len(bz) > 0 {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	if count >= limit {
+		return errorsmod.Wrapf(types.ErrSenderTxLimitExceeded, "sender %s already included %d transactions in the block", sender, count)
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(count+1))
+	return nil
+}
+
+// checkSenderValue validates that the sender of the message has enough funds to cover the value
+// transferred by the message. The fees are expected to be deducted already by the AnteHandler.
+func (k *Keeper) checkSenderValue(ctx sdk.Context, msg core.Message) error {
+	if msg.Value() == nil || msg.Value().Sign() <= 0 {
+		return nil
+	}
+
+	balance := k.GetBalance(ctx, msg.From())
+	if balance.Cmp(msg.Value()) < 0 {
+		return types.NewInsufficientFundsError(msg.Value(), balance)
+	}
+	return nil
+}
@@ -517,3 +517,56 @@ func (suite *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 	}
 	suite.enableFeemarket = false // reset flag
 }
+
+func (suite *KeeperTestSuite) TestCheckBaseFee() {
+	baseFee := big.NewInt(1000000000)
+
+	testCases := []struct {
+		name            string
+		enableFeemarket bool
+		gasFeeCap       *big.Int
+		expectPass      bool
+	}{
+		{"fee cap below base fee", true, new(big.Int).Sub(baseFee, big.NewInt(1)), false},
+		{"fee cap equal to base fee", true, baseFee, true},
+		{"fee cap above base fee", true, new(big.Int).Add(baseFee, big.NewInt(1)), true},
+		{"fee market disabled", false, big.NewInt(1), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = tc.enableFeemarket
+			suite.SetupTest()
+
+			to := common.HexToAddress(suite.address.String())
+			tx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 0, &to, nil, 21000, nil, tc.gasFeeCap, big.NewInt(1), nil, &ethtypes.AccessList{})
+
+			err := suite.app.EvmKeeper.CheckBaseFee(suite.ctx, tx)
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, evmtypes.ErrGasPriceTooLow)
+				suite.Require().Contains(err.Error(), baseFee.String())
+			}
+		})
+	}
+	suite.enableFeemarket = false
+}
+
+func (suite *KeeperTestSuite) TestCheckSenderTxLimit() {
+	suite.SetupTest()
+	const limit = 3
+	sender, other := common.BigToAddress(big.NewInt(1)), common.BigToAddress(big.NewInt(2))
+
+	for i := 0; i < limit; i++ {
+		suite.Require().NoError(suite.app.EvmKeeper.CheckSenderTxLimit(suite.ctx, sender, limit))
+	}
+	err := suite.app.EvmKeeper.CheckSenderTxLimit(suite.ctx, sender, limit)
+	suite.Require().ErrorIs(err, evmtypes.ErrSenderTxLimitExceeded)
+
+	// the limit is per sender
+	suite.Require().NoError(suite.app.EvmKeeper.CheckSenderTxLimit(suite.ctx, other, limit))
+
+	// no limit
+	suite.Require().NoError(suite.app.EvmKeeper.CheckSenderTxLimit(suite.ctx, sender, 0))
+}
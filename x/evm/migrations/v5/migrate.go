@@ -2,6 +2,7 @@ package v5
 
 import (
 	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/ethermint/x/evm/types"
@@ -9,87 +10,103 @@ import (
 	v5types "github.com/evmos/ethermint/x/evm/migrations/v5/types"
 )
 
-// MigrateStore migrates the x/evm module state from the consensus version 4 to
-// version 5. Specifically, it takes the parameters that are currently stored
-// in separate keys and stores them directly into the x/evm module state using
-// a single params key.
-func MigrateStore(
-	ctx sdk.Context,
-	storeService corestore.KVStoreService,
-	cdc codec.BinaryCodec,
-) error {
+// LoadParams reads the parameters stored in separate keys by the consensus version 4, the missing
+// params introduced later are set to their default value.
+func LoadParams(store corestore.KVStore, cdc codec.BinaryCodec) (types.Params, error) {
 	var (
 		extraEIPs   v5types.V5ExtraEIPs
 		chainConfig types.ChainConfig
 		params      types.Params
 	)
 
-	store := storeService.OpenKVStore(ctx)
-
 	value, err := store.Get(types.ParamStoreKeyEVMDenom)
 	if err != nil {
-		return err
+		return params, errorsmod.Wrap(err, "failed to load legacy evm denom param")
 	}
 	denom := string(value)
 
 	extraEIPsBz, err := store.Get(types.ParamStoreKeyExtraEIPs)
 	if err != nil {
-		return err
+		return params, errorsmod.Wrap(err, "failed to load legacy extra EIPs param")
 	}
-	cdc.MustUnmarshal(extraEIPsBz, &extraEIPs)
-
-	// revert ExtraEIP change for Evmos testnet
-	if ctx.ChainID() == "evmos_9000-4" {
-		extraEIPs.EIPs = []int64{}
+	if err := cdc.Unmarshal(extraEIPsBz, &extraEIPs); err != nil {
+		return params, errorsmod.Wrap(err, "failed to decode legacy extra EIPs param")
 	}
 
 	chainCfgBz, err := store.Get(types.ParamStoreKeyChainConfig)
 	if err != nil {
-		return err
+		return params, errorsmod.Wrap(err, "failed to load legacy chain config param")
+	}
+	if err := cdc.Unmarshal(chainCfgBz, &chainConfig); err != nil {
+		return params, errorsmod.Wrap(err, "failed to convert legacy chain config param")
 	}
-	cdc.MustUnmarshal(chainCfgBz, &chainConfig)
 
 	params.EvmDenom = denom
 	params.ExtraEIPs = extraEIPs.EIPs
 	params.ChainConfig = chainConfig
+	params.MaxCodeSize = types.DefaultMaxCodeSize
+	params.MaxInitCodeSize = types.DefaultMaxInitCodeSize
 	if params.EnableCreate, err = store.Has(types.ParamStoreKeyEnableCreate); err != nil {
-		return err
+		return params, errorsmod.Wrap(err, "failed to load legacy enable create param")
 	}
 	if params.EnableCall, err = store.Has(types.ParamStoreKeyEnableCall); err != nil {
-		return err
+		return params, errorsmod.Wrap(err, "failed to load legacy enable call param")
 	}
 	if params.AllowUnprotectedTxs, err = store.Has(types.ParamStoreKeyAllowUnprotectedTxs); err != nil {
-		return err
+		return params, errorsmod.Wrap(err, "failed to load legacy allow unprotected txs param")
 	}
 
-	if err = store.Delete(types.ParamStoreKeyChainConfig); err != nil {
-		return err
-	}
-	if err = store.Delete(types.ParamStoreKeyExtraEIPs); err != nil {
-		return err
-	}
-	if err = store.Delete(types.ParamStoreKeyEVMDenom); err != nil {
-		return err
-	}
-	if err = store.Delete(types.ParamStoreKeyEnableCreate); err != nil {
-		return err
-	}
-	if err = store.Delete(types.ParamStoreKeyEnableCall); err != nil {
+	return params, nil
+}
+
+// MigrateStore migrates the x/evm module state from the consensus version 4 to
+// version 5. Specifically, it takes the parameters that are currently stored
+// in separate keys and stores them directly into the x/evm module state using
+// a single params key.
+func MigrateStore(
+	ctx sdk.Context,
+	storeService corestore.KVStoreService,
+	cdc codec.BinaryCodec,
+) error {
+	store := storeService.OpenKVStore(ctx)
+
+	// load the legacy params
+	params, err := LoadParams(store, cdc)
+	if err != nil {
 		return err
 	}
-	if err = store.Delete(types.ParamStoreKeyAllowUnprotectedTxs); err != nil {
-		return err
+
+	// revert ExtraEIP change for Evmos testnet
+	if ctx.ChainID() == "evmos_9000-4" {
+		params.ExtraEIPs = []int64{}
 	}
 
+	// validate before deleting the legacy params
 	if err := params.Validate(); err != nil {
-		return err
+		return errorsmod.Wrap(err, "failed to validate migrated params")
 	}
 
-	bz := cdc.MustMarshal(&params)
+	// delete the legacy params
+	for _, key := range [][]byte{
+		types.ParamStoreKeyChainConfig,
+		types.ParamStoreKeyExtraEIPs,
+		types.ParamStoreKeyEVMDenom,
+		types.ParamStoreKeyEnableCreate,
+		types.ParamStoreKeyEnableCall,
+		types.ParamStoreKeyAllowUnprotectedTxs,
+	} {
+		if err := store.Delete(key); err != nil {
+			return errorsmod.Wrapf(err, "failed to delete legacy param %s", key)
+		}
+	}
 
-	err = store.Set(types.KeyPrefixParams, bz)
+	// write the migrated params
+	bz, err := cdc.Marshal(&params)
 	if err != nil {
-		return err
+		return errorsmod.Wrap(err, "failed to encode migrated params")
+	}
+	if err := store.Set(types.KeyPrefixParams, bz); err != nil {
+		return errorsmod.Wrap(err, "failed to write migrated params")
 	}
 
 	return nil
@@ -68,3 +68,31 @@ func TestMigrate(t *testing.T) {
 	value, _ = kvStore.Has(types.ParamStoreKeyChainConfig)
 	require.False(t, value)
 }
+
+func TestMigrateInvalidParams(t *testing.T) {
+	encCfg := encoding.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+
+	extraEIPsBz := cdc.MustMarshal(&v5types.V5ExtraEIPs{})
+	chainConfig := types.DefaultChainConfig()
+	chainConfigBz := cdc.MustMarshal(&chainConfig)
+
+	kvStore := storeService.OpenKVStore(ctx)
+	kvStore.Set(types.ParamStoreKeyEVMDenom, []byte("1nvalid-denom!"))
+	kvStore.Set(types.ParamStoreKeyExtraEIPs, extraEIPsBz)
+	kvStore.Set(types.ParamStoreKeyChainConfig, chainConfigBz)
+
+	err := v5.MigrateStore(ctx, storeService, cdc)
+	require.ErrorContains(t, err, "failed to validate migrated params")
+
+	// the legacy params are left untouched
+	value, _ := kvStore.Has(types.ParamStoreKeyEVMDenom)
+	require.True(t, value)
+	value, _ = kvStore.Has(types.KeyPrefixParams)
+	require.False(t, value)
+}
@@ -0,0 +1,100 @@
+package v6
+
+import (
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 5 to
+// version 6. Specifically, it sets the code size limits missing from the
+// params stored before they were introduced to their default values, and it
+// sets the code hash of the Ethereum accounts stored without one to the empty
+// code hash. The accounts that have a code hash are left untouched, and the
+// contracts among them are indexed.
+func MigrateStore(
+	ctx sdk.Context,
+	storeService corestore.KVStoreService,
+	ak types.AccountKeeper,
+	cdc codec.BinaryCodec,
+) error {
+	if err := migrateParams(ctx, storeService, cdc); err != nil {
+		return err
+	}
+
+	var (
+		accounts  []*ethermint.EthAccount
+		contracts []common.Address
+	)
+	ak.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		ethAcct, ok := account.(*ethermint.EthAccount)
+		switch {
+		case !ok:
+		case ethAcct.CodeHash == "":
+			accounts = append(accounts, ethAcct)
+		case types.IsContractCodeHash(ethAcct.GetCodeHash()):
+			contracts = append(contracts, ethAcct.EthAddress())
+		}
+		return false
+	})
+
+	// the accounts are updated after the iteration, to not write to the store being iterated
+	for _, account := range accounts {
+		if err := account.SetCodeHash(common.BytesToHash(types.EmptyCodeHash)); err != nil {
+			return err
+		}
+		ak.SetAccount(ctx, account)
+	}
+
+	store := storeService.OpenKVStore(ctx)
+	for _, contract := range contracts {
+		if err := store.Set(types.ContractKey(contract), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "failed to index contract")
+		}
+	}
+
+	return nil
+}
+
+// migrateParams sets the MaxCodeSize and MaxInitCodeSize params to their
+// default values when they are unset, which the params validation rejects.
+func migrateParams(ctx sdk.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.KeyPrefixParams)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to load params")
+	}
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return errorsmod.Wrap(err, "failed to decode params")
+	}
+	if params.MaxCodeSize == 0 {
+		params.MaxCodeSize = types.DefaultMaxCodeSize
+	}
+	if params.MaxInitCodeSize == 0 {
+		params.MaxInitCodeSize = types.DefaultMaxInitCodeSize
+	}
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(err, "failed to validate migrated params")
+	}
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to encode migrated params")
+	}
+	if err := store.Set(types.KeyPrefixParams, bz); err != nil {
+		return errorsmod.Wrap(err, "failed to write migrated params")
+	}
+
+	return nil
+}
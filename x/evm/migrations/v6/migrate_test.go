@@ -0,0 +1,89 @@
+package v6_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+	v6 "github.com/evmos/ethermint/x/evm/migrations/v6"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	ethermintApp := app.Setup(false, nil)
+	ctx := ethermintApp.BaseApp.NewContextLegacy(false, tmproto.Header{})
+	ak := ethermintApp.AccountKeeper
+	storeService := runtime.NewKVStoreService(ethermintApp.GetKey(types.StoreKey))
+	cdc := ethermintApp.AppCodec()
+
+	contractHash := common.BytesToHash([]byte("code"))
+	accounts := []struct {
+		codeHash    string
+		expCodeHash common.Hash
+		expContract bool
+	}{
+		{"", common.BytesToHash(types.EmptyCodeHash), false},
+		{contractHash.Hex(), contractHash, true},
+		{common.BytesToHash(types.EmptyCodeHash).Hex(), common.BytesToHash(types.EmptyCodeHash), false},
+	}
+
+	addrs := make([]common.Address, len(accounts))
+	for i, account := range accounts {
+		addrs[i] = tests.GenerateAddress()
+		ethAcct, ok := ak.NewAccountWithAddress(ctx, addrs[i].Bytes()).(*ethermint.EthAccount)
+		require.True(t, ok)
+		ethAcct.CodeHash = account.codeHash
+		ak.SetAccount(ctx, ethAcct)
+	}
+
+	require.NoError(t, v6.MigrateStore(ctx, storeService, ak, cdc))
+
+	for i, account := range accounts {
+		ethAcct, ok := ak.GetAccount(ctx, addrs[i].Bytes()).(*ethermint.EthAccount)
+		require.True(t, ok)
+		require.Equal(t, account.expCodeHash.Hex(), ethAcct.CodeHash)
+
+		indexed, err := storeService.OpenKVStore(ctx).Has(types.ContractKey(addrs[i]))
+		require.NoError(t, err)
+		require.Equal(t, account.expContract, indexed)
+	}
+}
+
+func TestMigrateParams(t *testing.T) {
+	ethermintApp := app.Setup(false, nil)
+	ctx := ethermintApp.BaseApp.NewContextLegacy(false, tmproto.Header{})
+	storeService := runtime.NewKVStoreService(ethermintApp.GetKey(types.StoreKey))
+	cdc := ethermintApp.AppCodec()
+	kvStore := storeService.OpenKVStore(ctx)
+
+	// the params stored by version 5 don't have the code size limits, which decode as zero
+	v5Params := types.DefaultParams()
+	v5Params.MaxCodeSize = 0
+	v5Params.MaxInitCodeSize = 0
+	require.Error(t, v5Params.Validate())
+	require.NoError(t, kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&v5Params)))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeService, ethermintApp.AccountKeeper, cdc))
+
+	params := ethermintApp.EvmKeeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultMaxCodeSize, params.MaxCodeSize)
+	require.Equal(t, types.DefaultMaxInitCodeSize, params.MaxInitCodeSize)
+	require.Equal(t, v5Params.EvmDenom, params.EvmDenom)
+	require.Equal(t, v5Params.ChainConfig, params.ChainConfig)
+
+	// the limits already set are kept
+	params.MaxCodeSize = 1000
+	params.MaxInitCodeSize = 2000
+	require.NoError(t, ethermintApp.EvmKeeper.SetParams(ctx, params))
+	require.NoError(t, v6.MigrateStore(ctx, storeService, ethermintApp.AccountKeeper, cdc))
+	params = ethermintApp.EvmKeeper.GetParams(ctx)
+	require.Equal(t, uint64(1000), params.MaxCodeSize)
+	require.Equal(t, uint64(2000), params.MaxInitCodeSize)
+}
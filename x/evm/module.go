@@ -45,9 +45,10 @@ var (
 	_ module.HasServices    = AppModule{}
 	_ module.HasABCIGenesis = AppModule{}
 
-	_ appmodule.AppModule       = AppModule{}
-	_ appmodule.HasBeginBlocker = AppModule{}
-	_ appmodule.HasEndBlocker   = AppModule{}
+	_ appmodule.AppModule            = AppModule{}
+	_ appmodule.HasBeginBlocker      = AppModule{}
+	_ appmodule.HasEndBlocker        = AppModule{}
+	_ appmodule.HasPrepareCheckState = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the evm module.
@@ -65,7 +66,7 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 // ConsensusVersion returns the consensus state-breaking version for the module.
 func (AppModuleBasic) ConsensusVersion() uint64 {
-	return 5
+	return 6
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the evm
@@ -157,6 +158,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
 		panic(err)
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(err)
+	}
 }
 
 // BeginBlock returns the begin block for the evm module.
@@ -170,6 +175,13 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	return am.keeper.EndBlock(ctx)
 }
 
+// PrepareCheckState resets the pending nonces of the evm module once a block is committed, the
+// transactions remaining in the mempool record them again when they're rechecked.
+func (am AppModule) PrepareCheckState(_ context.Context) error {
+	am.keeper.ResetPendingNonces()
+	return nil
+}
+
 // InitGenesis performs genesis initialization for the evm module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
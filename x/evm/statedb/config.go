@@ -59,4 +59,8 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides are applied to the state of the simulated calls, they can't be committed
+	Overrides StateOverride
+	// BlockOverrides are applied to the block context of the simulated calls
+	BlockOverrides *BlockOverrides
 }
@@ -0,0 +1,163 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package statedb
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Apply overrides the accounts of the StateDB. The overridden fields are seen as the committed
+// state of the accounts, they're never written to the keeper as long as the StateDB isn't committed.
+func (diff StateOverride) Apply(db *StateDB) error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		obj := db.getStateObject(addr)
+		if obj == nil {
+			// the overridden account stands for a committed one, it isn't created by the transaction
+			obj, _ = db.createObject(addr)
+			obj.created = false
+		}
+		if account.Nonce != nil {
+			obj.setNonce(uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			obj.setCode(crypto.Keccak256Hash(*account.Code), *account.Code)
+		}
+		if account.Balance != nil {
+			obj.setBalance(new(big.Int).Set((*big.Int)(*account.Balance)))
+		}
+		if account.State != nil {
+			// the storage is replaced, the missing keys are empty
+			obj.overriddenStorage = true
+			obj.originStorage = make(Storage, len(*account.State))
+			for key, value := range *account.State {
+				obj.originStorage[key] = value
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				obj.originStorage[key] = value
+			}
+		}
+	}
+	return nil
+}
+
+// Merge applies the changes of another diff on top of this one: the fields set in the other diff
+// take precedence, its storage changes are added to the ones already recorded and a replaced
+// storage discards them.
+func (diff StateOverride) Merge(other StateOverride) {
+	for addr, account := range other {
+		prev := diff[addr]
+		if account.Nonce != nil {
+			prev.Nonce = account.Nonce
+		}
+		if account.Code != nil {
+			prev.Code = account.Code
+		}
+		if account.Balance != nil {
+			prev.Balance = account.Balance
+		}
+		switch {
+		case account.State != nil:
+			state := copyStorage(*account.State)
+			prev.State, prev.StateDiff = &state, nil
+		case account.StateDiff != nil:
+			target := prev.StateDiff
+			if prev.State != nil {
+				target = prev.State
+			} else if target == nil {
+				stateDiff := make(map[common.Hash]common.Hash, len(*account.StateDiff))
+				target, prev.StateDiff = &stateDiff, &stateDiff
+			}
+			for key, value := range *account.StateDiff {
+				(*target)[key] = value
+			}
+		}
+		diff[addr] = prev
+	}
+}
+
+func copyStorage(storage map[common.Hash]common.Hash) map[common.Hash]common.Hash {
+	cpy := make(map[common.Hash]common.Hash, len(storage))
+	for key, value := range storage {
+		cpy[key] = value
+	}
+	return cpy
+}
+
+// Nonce returns the overridden nonce of the account, if any.
+func (diff StateOverride) Nonce(addr common.Address) (uint64, bool) {
+	account, ok := diff[addr]
+	if !ok || account.Nonce == nil {
+		return 0, false
+	}
+	return uint64(*account.Nonce), true
+}
+
+// BlockOverrides is the set of block context fields overridden during the execution of a message
+// call.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+	Coinbase *common.Address `json:"coinbase"`
+}
+
+// Apply overrides the fields of the block context, a nil BlockOverrides leaves it unchanged.
+func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		blockCtx.BlockNumber = new(big.Int).Set(diff.Number.ToInt())
+	}
+	if diff.Time != nil {
+		blockCtx.Time = new(big.Int).SetUint64(uint64(*diff.Time))
+	}
+	if diff.BaseFee != nil {
+		blockCtx.BaseFee = new(big.Int).Set(diff.BaseFee.ToInt())
+	}
+	if diff.Coinbase != nil {
+		blockCtx.Coinbase = *diff.Coinbase
+	}
+}
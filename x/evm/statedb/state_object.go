@@ -80,6 +80,10 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	created   bool // created in the current transaction
+
+	// the storage is replaced by a state override, the keeper isn't queried
+	overriddenStorage bool
 }
 
 // newObject creates a state object.
@@ -217,6 +221,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.overriddenStorage {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
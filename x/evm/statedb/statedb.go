@@ -16,6 +16,7 @@
 package statedb
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"sort"
@@ -23,6 +24,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -65,6 +67,9 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// EIP-6780: only the contracts created in the current transaction can be self-destructed
+	eip6780 bool
 }
 
 // New creates a new state from a given trie.
@@ -80,11 +85,22 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	}
 }
 
+// EnableEIP6780 applies the EIP-6780 (Cancun) semantics to SELFDESTRUCT: the accounts are only
+// deleted if they were created in the current transaction, otherwise only their balance is sent.
+func (s *StateDB) EnableEIP6780() {
+	s.eip6780 = true
+}
+
 // Keeper returns the underlying `Keeper`
 func (s *StateDB) Keeper() Keeper {
 	return s.keeper
 }
 
+// TxConfig returns the config of the transaction executed against the StateDB.
+func (s *StateDB) TxConfig() TxConfig {
+	return s.txConfig
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -208,6 +224,18 @@ func (s *StateDB) HasSuicided(addr common.Address) bool {
 	return false
 }
 
+// DestructedAccounts returns the accounts suicided in the current transaction, sorted by address.
+// They are deleted when the state is committed.
+func (s *StateDB) DestructedAccounts() []common.Address {
+	var addrs []common.Address
+	for _, addr := range s.journal.sortedDirties() {
+		if obj := s.stateObjects[addr]; obj != nil && obj.suicided {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
 // AddPreimage records a SHA3 preimage seen by the VM.
 // AddPreimage performs a no-op since the EnablePreimageRecording flag is disabled
 // on the vm.Config during state transitions. No store trie preimages are written
@@ -247,6 +275,7 @@ func (s *StateDB) createObject(addr common.Address) (newobj, prev *stateObject)
 	prev = s.getStateObject(addr)
 
 	newobj = newObject(s, addr, Account{})
+	newobj.created = true
 	if prev == nil {
 		s.journal.append(createObjectChange{account: &addr})
 	} else {
@@ -347,11 +376,18 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 //
 // The account's state object is still available until the state is committed,
 // getStateObject will return a non-nil account after Suicide.
+//
+// Once EIP-6780 is enabled, the accounts not created in the current transaction are not marked,
+// only the balance sent to the beneficiary is deducted, see selfBeneficiaryBalance.
 func (s *StateDB) Suicide(addr common.Address) bool {
 	stateObject := s.getStateObject(addr)
 	if stateObject == nil {
 		return false
 	}
+	if s.eip6780 && !stateObject.created {
+		stateObject.SetBalance(s.selfBeneficiaryBalance(addr))
+		return true
+	}
 	s.journal.append(suicideChange{
 		account:     &addr,
 		prev:        stateObject.suicided,
@@ -363,6 +399,22 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 	return true
 }
 
+// selfBeneficiaryBalance returns the balance an account not created in the current transaction keeps
+// when it self-destructs under EIP-6780. SELFDESTRUCT credits the beneficiary with the balance of the
+// contract right before calling Suicide, so the contract keeps its balance only when it's its own
+// beneficiary: the last journaled change is then the credit of its balance to itself.
+func (s *StateDB) selfBeneficiaryBalance(addr common.Address) *big.Int {
+	balance := s.GetBalance(addr)
+	if len(s.journal.entries) == 0 || balance.Sign() == 0 {
+		return new(big.Int)
+	}
+	change, ok := s.journal.entries[len(s.journal.entries)-1].(balanceChange)
+	if !ok || *change.account != addr || new(big.Int).Lsh(change.prev, 1).Cmp(balance) != 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(change.prev)
+}
+
 // PrepareAccessList handles the preparatory steps for executing a state transition with
 // regards to both EIP-2929 and EIP-2930:
 //
@@ -448,6 +500,102 @@ func (s *StateDB) RevertToSnapshot(revid int) {
 	s.validRevisions = s.validRevisions[:idx]
 }
 
+// CreatedContracts returns the addresses of the contracts created by the transaction and still alive,
+// whether they were created by the transaction itself or by a CREATE/CREATE2 opcode.
+func (s *StateDB) CreatedContracts() []common.Address {
+	var contracts []common.Address
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		if obj.created && !obj.suicided && obj.account.IsContract() {
+			contracts = append(contracts, addr)
+		}
+	}
+	return contracts
+}
+
+// GetDirtyAccounts returns the accounts whose nonce, balance or code changed since the StateDB was
+// constructed, the changes reverted to a snapshot are ignored. The destructed accounts are returned
+// as empty accounts. The accounts are compared to the keeper, it has to be called before committing.
+func (s *StateDB) GetDirtyAccounts() map[common.Address]Account {
+	dirty := make(map[common.Address]Account)
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		if obj.suicided {
+			dirty[addr] = *NewEmptyAccount()
+			continue
+		}
+
+		prev := s.keeper.GetAccount(s.ctx, addr)
+		if prev == nil {
+			prev = NewEmptyAccount()
+		}
+		if prev.Nonce == obj.account.Nonce && prev.Balance.Cmp(obj.account.Balance) == 0 &&
+			bytes.Equal(prev.CodeHash, obj.account.CodeHash) {
+			continue
+		}
+		dirty[addr] = Account{
+			Nonce:    obj.account.Nonce,
+			Balance:  new(big.Int).Set(obj.account.Balance),
+			CodeHash: common.CopyBytes(obj.account.CodeHash),
+		}
+	}
+	return dirty
+}
+
+// GetDirtyStorage returns the storage slots whose value changed since the StateDB was constructed,
+// the changes reverted to a snapshot or restoring the committed value are ignored. The storage of the
+// destructed accounts isn't returned.
+func (s *StateDB) GetDirtyStorage() map[common.Address]map[common.Hash]common.Hash {
+	dirty := make(map[common.Address]map[common.Hash]common.Hash)
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		if obj.suicided {
+			continue
+		}
+
+		storage := make(map[common.Hash]common.Hash)
+		for key, value := range obj.dirtyStorage {
+			if value != obj.originStorage[key] {
+				storage[key] = value
+			}
+		}
+		if len(storage) > 0 {
+			dirty[addr] = storage
+		}
+	}
+	return dirty
+}
+
+// StateDiff returns the changes made to the accounts, in the state override format. The destructed
+// accounts are reported with a zero nonce and balance, and an empty code and storage.
+func (s *StateDB) StateDiff() StateOverride {
+	diff := make(StateOverride)
+	for addr, account := range s.GetDirtyAccounts() {
+		nonce := hexutil.Uint64(account.Nonce)
+		balance := (*hexutil.Big)(account.Balance)
+		override := OverrideAccount{Nonce: &nonce, Balance: &balance}
+
+		obj := s.stateObjects[addr]
+		switch {
+		case obj.suicided:
+			code := hexutil.Bytes{}
+			state := make(map[common.Hash]common.Hash)
+			override.Code, override.State = &code, &state
+		case obj.code != nil && obj.dirtyCode:
+			code := hexutil.Bytes(obj.code)
+			override.Code = &code
+		}
+		diff[addr] = override
+	}
+	for addr, storage := range s.GetDirtyStorage() {
+		storage := storage
+		override := diff[addr]
+		override.StateDiff = &storage
+		diff[addr] = override
+	}
+	return diff
+}
+
 // Commit writes the dirty states to keeper
 // the StateDB object should be discarded after committed.
 func (s *StateDB) Commit() error {
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -565,6 +566,189 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+func (suite *StateDBTestSuite) TestStateOverride() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+
+	// commit an account with two storage slots
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(10))
+	db.SetState(address, key1, value1)
+	db.SetState(address, key2, value1)
+	suite.Require().NoError(db.Commit())
+
+	balance := (*hexutil.Big)(big.NewInt(100))
+	nonce := hexutil.Uint64(3)
+	code := hexutil.Bytes([]byte("code"))
+	state := map[common.Hash]common.Hash{key1: value2}
+
+	testCases := []struct {
+		name      string
+		override  statedb.OverrideAccount
+		expState1 common.Hash
+		expState2 common.Hash
+	}{
+		{"state diff", statedb.OverrideAccount{StateDiff: &state}, value2, value1},
+		{"state", statedb.OverrideAccount{State: &state}, value2, common.Hash{}},
+		{"account", statedb.OverrideAccount{Balance: &balance, Nonce: &nonce, Code: &code}, value1, value1},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			suite.Require().NoError(statedb.StateOverride{address: tc.override}.Apply(db))
+
+			suite.Require().Equal(tc.expState1, db.GetCommittedState(address, key1))
+			suite.Require().Equal(tc.expState2, db.GetState(address, key2))
+			if tc.override.Balance != nil {
+				suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+				suite.Require().Equal(uint64(3), db.GetNonce(address))
+				suite.Require().Equal([]byte("code"), db.GetCode(address))
+			}
+
+			// the keeper is left untouched
+			suite.Require().Equal(big.NewInt(10), keeper.accounts[address].account.Balance)
+			suite.Require().Equal(value1, keeper.accounts[address].states[key2])
+		})
+	}
+
+	// the overridden accounts aren't created by the transaction
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().NoError(statedb.StateOverride{address2: {Code: &code}}.Apply(db))
+	suite.Require().Equal([]byte("code"), db.GetCode(address2))
+	suite.Require().Empty(db.CreatedContracts())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	err := statedb.StateOverride{address: {State: &state, StateDiff: &state}}.Apply(db)
+	suite.Require().Error(err)
+}
+
+func (suite *StateDBTestSuite) TestDirtyStorage() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	key3 := common.BigToHash(big.NewInt(3))
+	value1 := common.BigToHash(big.NewInt(4))
+	value2 := common.BigToHash(big.NewInt(5))
+	address2 := common.BigToAddress(big.NewInt(101))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(10))
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key2, value1)
+	snapshot := db.Snapshot()
+	db.SetState(address, key2, value2)
+	db.SetState(address, key3, value2)
+	db.SetState(address2, key1, value1)
+	db.AddBalance(address2, big.NewInt(1))
+	db.RevertToSnapshot(snapshot)
+	// restoring the committed value isn't a change
+	db.SetState(address, key1, value2)
+	db.SetState(address, key1, value1)
+	db.SetNonce(address, 2)
+
+	suite.Require().Equal(map[common.Address]map[common.Hash]common.Hash{
+		address: {key2: value1},
+	}, db.GetDirtyStorage())
+
+	accounts := db.GetDirtyAccounts()
+	suite.Require().Len(accounts, 1)
+	suite.Require().Equal(uint64(2), accounts[address].Nonce)
+	suite.Require().Equal(big.NewInt(10), accounts[address].Balance)
+
+	// only the storage is changed
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key3, value2)
+	suite.Require().Empty(db.GetDirtyAccounts())
+	suite.Require().Len(db.GetDirtyStorage(), 1)
+}
+
+func (suite *StateDBTestSuite) TestStateDiff() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(10))
+	db.SetCode(address, []byte("code"))
+	db.SetState(address, key1, value1)
+	diff := db.StateDiff()
+	suite.Require().NoError(db.Commit())
+
+	suite.Require().Len(diff, 1)
+	suite.Require().Equal(hexutil.Uint64(0), *diff[address].Nonce)
+	suite.Require().Equal(big.NewInt(10), (*diff[address].Balance).ToInt())
+	suite.Require().Equal(hexutil.Bytes("code"), *diff[address].Code)
+	suite.Require().Equal(map[common.Hash]common.Hash{key1: value1}, *diff[address].StateDiff)
+
+	// the unchanged code isn't reported, the storage changes are merged
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetNonce(address, 1)
+	db.SetState(address, key1, value1)
+	db.SetState(address, key2, value2)
+	diff.Merge(db.StateDiff())
+	suite.Require().Equal(hexutil.Uint64(1), *diff[address].Nonce)
+	suite.Require().Equal(hexutil.Bytes("code"), *diff[address].Code)
+	suite.Require().Equal(map[common.Hash]common.Hash{key1: value1, key2: value2}, *diff[address].StateDiff)
+
+	// destructed accounts have an empty storage
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.Suicide(address)
+	diff.Merge(db.StateDiff())
+	suite.Require().Nil(diff[address].StateDiff)
+	suite.Require().Empty(*diff[address].State)
+	suite.Require().Empty(*diff[address].Code)
+	suite.Require().Equal(int64(0), (*diff[address].Balance).ToInt().Int64())
+}
+
+func (suite *StateDBTestSuite) TestSuicideEIP6780() {
+	// SELFDESTRUCT credits the beneficiary with the balance of the contract, then calls Suicide
+	testCases := []struct {
+		name        string
+		beneficiary common.Address
+		balance     int64
+		expBalance  int64
+	}{
+		{"other beneficiary", address2, 100, 0},
+		{"itself as beneficiary", address, 100, 100},
+		{"itself as beneficiary, empty balance", address, 0, 0},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := NewMockKeeper()
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			db.CreateAccount(address)
+			db.SetCode(address, []byte("hello world"))
+			db.AddBalance(address, big.NewInt(tc.balance))
+			suite.Require().NoError(db.Commit())
+
+			db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			db.EnableEIP6780()
+			snapshot := db.Snapshot()
+			// a credit earlier in the tx doesn't count
+			db.AddBalance(address2, big.NewInt(tc.balance))
+			db.AddBalance(tc.beneficiary, db.GetBalance(address))
+			suite.Require().True(db.Suicide(address))
+
+			// the contract isn't destructed, it only sends its balance
+			suite.Require().False(db.HasSuicided(address))
+			suite.Require().Equal(big.NewInt(tc.expBalance), db.GetBalance(address))
+			suite.Require().Equal([]byte("hello world"), db.GetCode(address))
+
+			// the balance change is journaled
+			db.RevertToSnapshot(snapshot)
+			suite.Require().Equal(big.NewInt(tc.balance), db.GetBalance(address))
+		})
+	}
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	db.ForEachStorage(address, func(k, v common.Hash) bool {
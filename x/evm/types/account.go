@@ -0,0 +1,46 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+// AccountKind classifies an EVM address by the state it holds.
+type AccountKind uint8
+
+const (
+	// AccountKindEmpty is an address without nonce, balance nor code, as defined by EIP-161.
+	AccountKindEmpty AccountKind = iota
+	// AccountKindEOA is an externally owned account: it has a nonce or a balance but no code.
+	AccountKindEOA
+	// AccountKindContract is an account holding contract code.
+	AccountKindContract
+	// AccountKindPrecompile is the address of a precompiled contract enabled on the chain.
+	AccountKindPrecompile
+)
+
+// String implements fmt.Stringer.
+func (k AccountKind) String() string {
+	switch k {
+	case AccountKindEmpty:
+		return "empty"
+	case AccountKindEOA:
+		return "eoa"
+	case AccountKindContract:
+		return "contract"
+	case AccountKindPrecompile:
+		return "precompile"
+	default:
+		return "unknown"
+	}
+}
@@ -56,6 +56,46 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	}
 }
 
+// ActiveForks returns the names of the hard forks that are active at the given block height,
+// in activation order. The result is derived from the go-ethereum ChainConfig produced by
+// EthereumConfig, so it follows the same rules the EVM uses during state transitions.
+func (cc ChainConfig) ActiveForks(chainID *big.Int, height int64) []string {
+	ethCfg := cc.EthereumConfig(chainID)
+	num := big.NewInt(height)
+
+	forks := []struct {
+		name   string
+		active bool
+	}{
+		{"homestead", ethCfg.IsHomestead(num)},
+		{"daoFork", ethCfg.IsDAOFork(num)},
+		{"eip150", ethCfg.IsEIP150(num)},
+		{"eip155", ethCfg.IsEIP155(num)},
+		{"eip158", ethCfg.IsEIP158(num)},
+		{"byzantium", ethCfg.IsByzantium(num)},
+		{"constantinople", ethCfg.IsConstantinople(num)},
+		{"petersburg", ethCfg.IsPetersburg(num)},
+		{"istanbul", ethCfg.IsIstanbul(num)},
+		{"muirGlacier", ethCfg.IsMuirGlacier(num)},
+		{"berlin", ethCfg.IsBerlin(num)},
+		{"london", ethCfg.IsLondon(num)},
+		{"arrowGlacier", ethCfg.IsArrowGlacier(num)},
+		{"grayGlacier", ethCfg.IsGrayGlacier(num)},
+		{"mergeNetsplit", ethCfg.MergeNetsplitBlock != nil && ethCfg.MergeNetsplitBlock.Cmp(num) <= 0},
+		{"shanghai", ethCfg.IsShanghai(num)},
+		{"cancun", ethCfg.IsCancun(num)},
+	}
+
+	active := make([]string, 0, len(forks))
+	for _, fork := range forks {
+		if fork.active {
+			active = append(active, fork.name)
+		}
+	}
+
+	return active
+}
+
 // DefaultChainConfig returns default evm parameters.
 func DefaultChainConfig() ChainConfig {
 	homesteadBlock := sdkmath.ZeroInt()
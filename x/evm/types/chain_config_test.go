@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -379,3 +380,37 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestChainConfigActiveForks(t *testing.T) {
+	cfg := DefaultChainConfig()
+	cfg.LondonBlock = newIntPtr(10)
+	cfg.ArrowGlacierBlock = newIntPtr(10)
+	cfg.GrayGlacierBlock = newIntPtr(10)
+	cfg.MergeNetsplitBlock = newIntPtr(10)
+	cfg.ShanghaiBlock = newIntPtr(20)
+	cfg.CancunBlock = nil
+
+	preLondon := []string{
+		"homestead", "daoFork", "eip150", "eip155", "eip158", "byzantium",
+		"constantinople", "petersburg", "istanbul", "muirGlacier", "berlin",
+	}
+	postLondon := append(append([]string{}, preLondon...), "london", "arrowGlacier", "grayGlacier", "mergeNetsplit")
+	postShanghai := append(append([]string{}, postLondon...), "shanghai")
+
+	testCases := []struct {
+		name   string
+		height int64
+		forks  []string
+	}{
+		{"before london block", 9, preLondon},
+		{"london block", 10, postLondon},
+		{"after london block", 11, postLondon},
+		{"shanghai block", 20, postShanghai},
+	}
+
+	for _, tc := range testCases {
+		forks := cfg.ActiveForks(big.NewInt(9000), tc.height)
+		require.Equal(t, tc.forks, forks, tc.name)
+		require.NotContains(t, forks, "cancun", tc.name)
+	}
+}
@@ -51,6 +51,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgResetParams{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -98,5 +99,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "ethermint/x/evm/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgResetParams{}, "ethermint/x/evm/MsgResetParams", nil)
 	cdc.RegisterConcrete(&Params{}, "ethermint/x/evm/Params", nil)
 }
@@ -20,7 +20,9 @@ import (
 	_ "embed"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
@@ -103,30 +105,47 @@ var (
 )
 
 func init() {
-	err := json.Unmarshal(erc20JSON, &ERC20Contract)
+	ERC20Contract = mustLoadCompiledContract("ERC20", erc20JSON)
+	TestMessageCall = mustLoadCompiledContract("TestMessageCall", testMessageCallJSON)
+	SimpleStorageContract = mustLoadCompiledContract("SimpleStorage", simpleStorageJSON)
+}
+
+// mustLoadCompiledContract loads the compiled contract with LoadCompiledContract, it panics with
+// an error naming the contract if it's invalid.
+func mustLoadCompiledContract(name string, raw []byte) CompiledContract {
+	contract, err := LoadCompiledContract(raw)
 	if err != nil {
-		panic(err)
+		panic(fmt.Errorf("load %s contract failed: %w", name, err))
 	}
+	return contract
+}
 
-	if len(ERC20Contract.Bin) == 0 {
-		panic("load contract failed")
+// LoadCompiledContract decodes the JSON of a compiled contract and validates it: the bytecode must
+// be a non empty hex string, the ABI must parse and the constructor arguments must be packable.
+func LoadCompiledContract(raw []byte) (CompiledContract, error) {
+	var contract CompiledContract
+	if err := json.Unmarshal(raw, &contract); err != nil {
+		return CompiledContract{}, err
 	}
 
-	err = json.Unmarshal(testMessageCallJSON, &TestMessageCall)
-	if err != nil {
-		panic(err)
+	if len(contract.Bin) == 0 {
+		return CompiledContract{}, errors.New("empty contract bytecode")
 	}
 
-	if len(TestMessageCall.Bin) == 0 {
-		panic("load contract failed")
+	// pack the zero values of the constructor arguments to check they're consistent with the ABI
+	args := make([]interface{}, len(contract.ABI.Constructor.Inputs))
+	for i, input := range contract.ABI.Constructor.Inputs {
+		typ := input.Type.GetType()
+		if typ.Kind() == reflect.Ptr {
+			args[i] = reflect.New(typ.Elem()).Interface()
+		} else {
+			args[i] = reflect.New(typ).Elem().Interface()
+		}
 	}
 
-	err = json.Unmarshal(simpleStorageJSON, &SimpleStorageContract)
-	if err != nil {
-		panic(err)
+	if _, err := contract.ABI.Pack("", args...); err != nil {
+		return CompiledContract{}, fmt.Errorf("failed to pack constructor arguments: %w", err)
 	}
 
-	if len(TestMessageCall.Bin) == 0 {
-		panic("load contract failed")
-	}
+	return contract, nil
 }
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCompiledContract(t *testing.T) {
+	testCases := []struct {
+		name   string
+		raw    []byte
+		expErr string
+	}{
+		{
+			"valid contract",
+			erc20JSON,
+			"",
+		},
+		{
+			"empty bin",
+			[]byte(`{"abi": "[]", "bin": ""}`),
+			"empty contract bytecode",
+		},
+		{
+			"invalid hex bin",
+			[]byte(`{"abi": "[]", "bin": "0xzz"}`),
+			"invalid byte",
+		},
+		{
+			"malformed abi",
+			[]byte(`{"abi": "[{\"type\": \"constructor\", \"inputs\": [{\"type\": \"foo\"}]}]", "bin": "6080"}`),
+			"failed to unmarshal ABI",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contract, err := LoadCompiledContract(tc.raw)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, ERC20Contract, contract)
+			require.NotEmpty(t, contract.Bin)
+			require.Len(t, contract.ABI.Constructor.Inputs, 2)
+		})
+	}
+}
+
+func TestMustLoadCompiledContract(t *testing.T) {
+	require.NotPanics(t, func() {
+		mustLoadCompiledContract("SimpleStorage", simpleStorageJSON)
+	})
+
+	require.PanicsWithError(t, "load SimpleStorage contract failed: empty contract bytecode", func() {
+		mustLoadCompiledContract("SimpleStorage", []byte(`{"abi": "[]", "bin": ""}`))
+	})
+}
@@ -18,6 +18,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/common"
@@ -49,6 +50,16 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrInsufficientFunds
+	codeErrUnsupportedTxType
+	codeErrMaxCodeSizeExceeded
+	codeErrMaxInitCodeSizeExceeded
+	codeErrGasPriceTooLow
+	codeErrBlockedAddress
+	codeErrRedundantExtraEIP
+	codeErrSenderTxLimitExceeded
+	codeErrBaseFeeConfigMismatch
+	codeErrTxGasLimitExceeded
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -116,6 +127,36 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrInsufficientFunds returns an error if the sender balance can't cover the tx cost
+	ErrInsufficientFunds = errorsmod.Register(ModuleName, codeErrInsufficientFunds, "insufficient funds")
+
+	// ErrUnsupportedTxType returns an error if the ethereum transaction type is not supported
+	ErrUnsupportedTxType = errorsmod.Register(ModuleName, codeErrUnsupportedTxType, "transaction type not supported")
+
+	// ErrMaxCodeSizeExceeded returns an error if the deployed contract code exceeds the MaxCodeSize parameter
+	ErrMaxCodeSizeExceeded = errorsmod.Register(ModuleName, codeErrMaxCodeSizeExceeded, "max code size exceeded")
+
+	// ErrMaxInitCodeSizeExceeded returns an error if the init code of a contract creation exceeds the MaxInitCodeSize parameter
+	ErrMaxInitCodeSizeExceeded = errorsmod.Register(ModuleName, codeErrMaxInitCodeSizeExceeded, "max initcode size exceeded")
+
+	// ErrGasPriceTooLow returns an error if the fee cap of the transaction is below the base fee of the block
+	ErrGasPriceTooLow = errorsmod.Register(ModuleName, codeErrGasPriceTooLow, "gas price too low")
+
+	// ErrBlockedAddress returns an error if the sender can't deploy contracts or the callee can't be called
+	ErrBlockedAddress = errorsmod.Register(ModuleName, codeErrBlockedAddress, "blocked address")
+
+	// ErrRedundantExtraEIP returns an error if an extra EIP is already included in an active hard fork.
+	ErrRedundantExtraEIP = errorsmod.Register(ModuleName, codeErrRedundantExtraEIP, "extra EIP already included in an active hard fork")
+
+	// ErrSenderTxLimitExceeded returns an error if a sender exceeds the number of transactions allowed per block.
+	ErrSenderTxLimitExceeded = errorsmod.Register(ModuleName, codeErrSenderTxLimitExceeded, "sender transactions per block limit exceeded")
+
+	// ErrBaseFeeConfigMismatch returns an error if the fee market and the London hard fork disagree on whether the base fee is active.
+	ErrBaseFeeConfigMismatch = errorsmod.Register(ModuleName, codeErrBaseFeeConfigMismatch, "fee market and london hard fork base fee configuration mismatch")
+
+	// ErrTxGasLimitExceeded returns an error if a transaction declares a gas limit above the maximum allowed.
+	ErrTxGasLimitExceeded = errorsmod.Register(ModuleName, codeErrTxGasLimitExceeded, "transaction gas limit exceeded")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -150,3 +191,34 @@ func (e *RevertError) ErrorCode() int {
 func (e *RevertError) ErrorData() interface{} {
 	return e.reason
 }
+
+// InsufficientFundsError is returned when the sender balance can't cover the tx cost. It carries
+// the required and available amounts so they can be reported back to the JSON-RPC client.
+type InsufficientFundsError struct {
+	Required  *big.Int
+	Available *big.Int
+}
+
+// NewInsufficientFundsError returns an InsufficientFundsError for the given amounts.
+func NewInsufficientFundsError(required, available *big.Int) *InsufficientFundsError {
+	return &InsufficientFundsError{
+		Required:  required,
+		Available: available,
+	}
+}
+
+// Error implements the error interface.
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("%s: sender balance < tx cost (%s < %s)", ErrInsufficientFunds, e.Available, e.Required)
+}
+
+// Unwrap returns the ErrInsufficientFunds sentinel, so the error can be matched with errors.Is.
+func (e *InsufficientFundsError) Unwrap() error {
+	return ErrInsufficientFunds
+}
+
+// Cause returns the ErrInsufficientFunds sentinel, so the ABCI code of the error is the one of the
+// sentinel.
+func (e *InsufficientFundsError) Cause() error {
+	return ErrInsufficientFunds
+}
@@ -21,6 +21,10 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
 
+	EventTypeDisablePrecompile = "disable_precompile"
+	EventTypeSelfDestruct      = "selfdestruct"
+	EventTypeResetParams       = "reset_params"
+
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
 	AttributeKeyTxHash          = "txHash"
@@ -33,6 +37,8 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyPrecompile       = "precompile"
+	AttributeKeyParam            = "param"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
@@ -42,6 +42,27 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// max_code_size defines the maximum size in bytes of the runtime code of a
+	// contract deployed by a transaction, it can't exceed the EIP-170 limit.
+	MaxCodeSize uint64 `protobuf:"varint,7,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// max_init_code_size defines the maximum size in bytes of the init code of a
+	// contract creation transaction once the Shanghai fork is active (EIP-3860).
+	MaxInitCodeSize uint64 `protobuf:"varint,8,opt,name=max_init_code_size,json=maxInitCodeSize,proto3" json:"max_init_code_size,omitempty"`
+	// blocked_contract_creators defines the hex addresses of the senders that
+	// can't send contract creation transactions, even with contract creation
+	// enabled. It only filters the transactions: the contracts deployed by a
+	// factory contract on behalf of a blocked sender aren't checked.
+	BlockedContractCreators []string `protobuf:"bytes,9,rep,name=blocked_contract_creators,json=blockedContractCreators,proto3" json:"blocked_contract_creators,omitempty"`
+	// blocked_callees defines the hex addresses of the contracts that can't be
+	// the recipient of a transaction, even with calls enabled. It only filters
+	// the transactions: the calls made by other contracts aren't checked.
+	BlockedCallees []string `protobuf:"bytes,10,rep,name=blocked_callees,json=blockedCallees,proto3" json:"blocked_callees,omitempty"`
+	// max_sender_txs_per_block defines the maximum number of ethereum
+	// transactions a sender can include in a block, zero means no limit.
+	MaxSenderTxsPerBlock uint64 `protobuf:"varint,11,opt,name=max_sender_txs_per_block,json=maxSenderTxsPerBlock,proto3" json:"max_sender_txs_per_block,omitempty"`
+	// max_tx_gas_limit defines the maximum gas limit an ethereum transaction can
+	// declare, zero means no limit.
+	MaxTxGasLimit uint64 `protobuf:"varint,12,opt,name=max_tx_gas_limit,json=maxTxGasLimit,proto3" json:"max_tx_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -119,6 +140,48 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInitCodeSize() uint64 {
+	if m != nil {
+		return m.MaxInitCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetBlockedContractCreators() []string {
+	if m != nil {
+		return m.BlockedContractCreators
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedCallees() []string {
+	if m != nil {
+		return m.BlockedCallees
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSenderTxsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSenderTxsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTxGasLimit() uint64 {
+	if m != nil {
+		return m.MaxTxGasLimit
+	}
+	return 0
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -499,6 +562,346 @@ func (m *TxResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TxResult proto.InternalMessageInfo
 
+// TxReceipt is the compact receipt stored for every executed ethereum transaction, from which the
+// `eth_getTransactionReceipt` response can be rebuilt.
+type TxReceipt struct {
+	// tx_hash is the ethereum transaction hash
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"transactionHash"`
+	// block_number of the block in which the transaction was included
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
+	// tx_index of the transaction in the block
+	TxIndex uint64 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"transactionIndex"`
+	// status is 1 if the transaction succeeded and 0 if it failed
+	Status uint64 `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	// gas_used by the transaction
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gasUsed"`
+	// cumulative_gas_used by the block up to and including the transaction
+	CumulativeGasUsed uint64 `protobuf:"varint,6,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulativeGasUsed"`
+	// contract_address of the contract created by the transaction, empty if the
+	// transaction is not a contract deployment.
+	ContractAddress string `protobuf:"bytes,7,opt,name=contract_address,json=contractAddress,proto3" json:"contractAddress"`
+	// logs emitted by the transaction
+	Logs []*Log `protobuf:"bytes,8,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *TxReceipt) Reset()         { *m = TxReceipt{} }
+func (m *TxReceipt) String() string { return proto.CompactTextString(m) }
+func (*TxReceipt) ProtoMessage()    {}
+func (*TxReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+}
+func (m *TxReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReceipt.Merge(m, src)
+}
+func (m *TxReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReceipt proto.InternalMessageInfo
+
+func (m *TxReceipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TxReceipt) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *TxReceipt) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *TxReceipt) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *TxReceipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *TxReceipt) GetCumulativeGasUsed() uint64 {
+	if m != nil {
+		return m.CumulativeGasUsed
+	}
+	return 0
+}
+
+func (m *TxReceipt) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *TxReceipt) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+// CosmosTxInfo locates the cosmos transaction that wrapped an ethereum transaction.
+type CosmosTxInfo struct {
+	// cosmos_tx_hash is the hex encoded hash of the cosmos transaction
+	CosmosTxHash string `protobuf:"bytes,1,opt,name=cosmos_tx_hash,json=cosmosTxHash,proto3" json:"cosmos_tx_hash,omitempty"`
+	// block_height of the block in which the transaction was included
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// tx_index of the ethereum transaction in the block
+	TxIndex uint64 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+}
+
+func (m *CosmosTxInfo) Reset()         { *m = CosmosTxInfo{} }
+func (m *CosmosTxInfo) String() string { return proto.CompactTextString(m) }
+func (*CosmosTxInfo) ProtoMessage()    {}
+func (*CosmosTxInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+}
+func (m *CosmosTxInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosTxInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosTxInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosTxInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosTxInfo.Merge(m, src)
+}
+func (m *CosmosTxInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosTxInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosTxInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosTxInfo proto.InternalMessageInfo
+
+func (m *CosmosTxInfo) GetCosmosTxHash() string {
+	if m != nil {
+		return m.CosmosTxHash
+	}
+	return ""
+}
+
+func (m *CosmosTxInfo) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *CosmosTxInfo) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+// ContractCreation locates the ethereum transaction that created a contract.
+type ContractCreation struct {
+	// creator is the hex formatted address of the sender of the creation transaction
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// tx_hash is the hex encoded hash of the creation transaction
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// block_height of the block in which the contract was created
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *ContractCreation) Reset()         { *m = ContractCreation{} }
+func (m *ContractCreation) String() string { return proto.CompactTextString(m) }
+func (*ContractCreation) ProtoMessage()    {}
+func (*ContractCreation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+}
+func (m *ContractCreation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCreation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCreation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCreation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCreation.Merge(m, src)
+}
+func (m *ContractCreation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCreation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCreation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCreation proto.InternalMessageInfo
+
+func (m *ContractCreation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ContractCreation) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ContractCreation) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// AccountDump is the complete EVM view of an account.
+type AccountDump struct {
+	// address is the hex formatted ethereum address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance of the account in the EVM denomination
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// nonce is the account's sequence number
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// code_hash is the hex formatted hash of the account code
+	CodeHash string `protobuf:"bytes,4,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// code of the account, empty if the account isn't a contract
+	Code []byte `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	// storage is the set of storage slots of the account, ordered by key
+	Storage Storage `protobuf:"bytes,6,rep,name=storage,proto3,castrepeated=Storage" json:"storage"`
+	// storage_truncated is set when the storage holds more slots than the
+	// requested limit
+	StorageTruncated bool `protobuf:"varint,7,opt,name=storage_truncated,json=storageTruncated,proto3" json:"storage_truncated,omitempty"`
+}
+
+func (m *AccountDump) Reset()         { *m = AccountDump{} }
+func (m *AccountDump) String() string { return proto.CompactTextString(m) }
+func (*AccountDump) ProtoMessage()    {}
+func (*AccountDump) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+}
+func (m *AccountDump) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountDump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountDump.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountDump) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDump.Merge(m, src)
+}
+func (m *AccountDump) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountDump) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDump.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDump proto.InternalMessageInfo
+
+func (m *AccountDump) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountDump) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AccountDump) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AccountDump) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *AccountDump) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *AccountDump) GetStorage() Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *AccountDump) GetStorageTruncated() bool {
+	if m != nil {
+		return m.StorageTruncated
+	}
+	return false
+}
+
 // AccessTuple is the element type of an access list.
 type AccessTuple struct {
 	// address is a hex formatted ethereum address
@@ -511,7 +914,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +974,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,6 +1087,10 @@ func init() {
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
 	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
+	proto.RegisterType((*TxReceipt)(nil), "ethermint.evm.v1.TxReceipt")
+	proto.RegisterType((*CosmosTxInfo)(nil), "ethermint.evm.v1.CosmosTxInfo")
+	proto.RegisterType((*ContractCreation)(nil), "ethermint.evm.v1.ContractCreation")
+	proto.RegisterType((*AccountDump)(nil), "ethermint.evm.v1.AccountDump")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
 }
@@ -691,111 +1098,138 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x97, 0x4f, 0x6f, 0x23, 0xb7,
-	0x15, 0xc0, 0x6d, 0x4b, 0xb6, 0x47, 0x94, 0x2c, 0x8d, 0x69, 0xd9, 0xd1, 0x7a, 0x51, 0x8f, 0x3b,
-	0x87, 0xc2, 0x0d, 0x12, 0x3b, 0x76, 0x60, 0x74, 0xb1, 0x41, 0x83, 0x58, 0xbb, 0xde, 0xd6, 0xee,
-	0x26, 0x35, 0xb8, 0x0e, 0x0a, 0xf4, 0x32, 0xa0, 0x66, 0x98, 0xd1, 0xc4, 0x33, 0x43, 0x81, 0xe4,
-	0x68, 0xa5, 0x7e, 0x82, 0xa2, 0xbd, 0xf4, 0x23, 0xe4, 0x58, 0xa0, 0x97, 0x1c, 0xfa, 0x21, 0x82,
-	0x9e, 0x82, 0x9e, 0x8a, 0x1e, 0x06, 0x85, 0xf7, 0x10, 0xc0, 0xbd, 0xf9, 0x13, 0x14, 0xfc, 0xa3,
-	0x7f, 0x23, 0x57, 0xf1, 0xc5, 0x9e, 0xc7, 0xf7, 0xe7, 0xc7, 0xf7, 0xf8, 0x28, 0x92, 0x60, 0x97,
-	0x88, 0x2e, 0x61, 0x49, 0x94, 0x8a, 0x23, 0xd2, 0x4f, 0x8e, 0xfa, 0xc7, 0xf2, 0xdf, 0x61, 0x8f,
-	0x51, 0x41, 0xa1, 0x3d, 0xd6, 0x1d, 0xca, 0xc1, 0xfe, 0xf1, 0x6e, 0x33, 0xa4, 0x21, 0x55, 0xca,
-	0x23, 0xf9, 0xa5, 0xed, 0x76, 0x37, 0x71, 0x12, 0xa5, 0xf4, 0x48, 0xfd, 0x35, 0x43, 0x4f, 0x7c,
-	0xca, 0x13, 0xca, 0x3d, 0x6d, 0xab, 0x05, 0xad, 0x72, 0xff, 0x56, 0x02, 0x6b, 0x57, 0x98, 0xe1,
-	0x84, 0xc3, 0x63, 0x50, 0x21, 0xfd, 0xc4, 0x0b, 0x48, 0x4a, 0x93, 0xd6, 0xf2, 0xfe, 0xf2, 0x41,
-	0xa5, 0xdd, 0xbc, 0xcf, 0x1d, 0x7b, 0x88, 0x93, 0xf8, 0xb9, 0x3b, 0x56, 0xb9, 0xc8, 0x22, 0xfd,
-	0xe4, 0xa5, 0xfc, 0x84, 0xbf, 0x04, 0x1b, 0x24, 0xc5, 0x9d, 0x98, 0x78, 0x3e, 0x23, 0x58, 0x90,
-	0xd6, 0xca, 0xfe, 0xf2, 0x81, 0xd5, 0x6e, 0xdd, 0xe7, 0x4e, 0xd3, 0xb8, 0x4d, 0xab, 0x5d, 0x54,
-	0xd3, 0xf2, 0x0b, 0x25, 0xc2, 0x5f, 0x80, 0xea, 0x48, 0x8f, 0xe3, 0xb8, 0x55, 0x52, 0xce, 0x3b,
-	0xf7, 0xb9, 0x03, 0x67, 0x9d, 0x71, 0x1c, 0xbb, 0x08, 0x18, 0x57, 0x1c, 0xc7, 0xf0, 0x0c, 0x00,
-	0x32, 0x10, 0x0c, 0x7b, 0x24, 0xea, 0xf1, 0x56, 0x79, 0xbf, 0x74, 0x50, 0x6a, 0xbb, 0xb7, 0xb9,
-	0x53, 0x39, 0x97, 0xa3, 0xe7, 0x17, 0x57, 0xfc, 0x3e, 0x77, 0x36, 0x4d, 0x90, 0xb1, 0xa1, 0x8b,
-	0x2a, 0x4a, 0x38, 0x8f, 0x7a, 0x1c, 0x76, 0x40, 0xcd, 0xef, 0xe2, 0x28, 0xf5, 0x7c, 0x9a, 0x7e,
-	0x15, 0x85, 0xad, 0xd5, 0xfd, 0xe5, 0x83, 0xea, 0xc9, 0x4f, 0x0e, 0x8b, 0x55, 0x3e, 0x7c, 0x21,
-	0xad, 0x5e, 0x28, 0xa3, 0xf6, 0xfe, 0x77, 0xb9, 0xb3, 0x74, 0x9f, 0x3b, 0x5b, 0x3a, 0xf4, 0x74,
-	0x00, 0xf7, 0xaf, 0x3f, 0x7c, 0xfb, 0xfe, 0x32, 0xaa, 0xfa, 0x13, 0x73, 0x78, 0x02, 0xb6, 0x71,
-	0x1c, 0xd3, 0xb7, 0x5e, 0x96, 0xca, 0x6a, 0x13, 0x5f, 0x90, 0xc0, 0x13, 0x03, 0xde, 0x5a, 0x93,
-	0x99, 0xa2, 0x2d, 0xa5, 0xfc, 0x72, 0xa2, 0xbb, 0x1e, 0xf0, 0xe7, 0x4f, 0xff, 0xf4, 0xc3, 0xb7,
-	0xef, 0xef, 0x4c, 0xfa, 0x60, 0xa0, 0x3a, 0x41, 0x2f, 0x91, 0xfb, 0x5f, 0x1b, 0x54, 0xa7, 0xe6,
-	0x03, 0xbf, 0x06, 0x8d, 0x2e, 0x4d, 0x08, 0x17, 0x04, 0x07, 0x5e, 0x27, 0xa6, 0xfe, 0x8d, 0x59,
-	0xb8, 0xb3, 0x7f, 0xe7, 0xce, 0xb6, 0x5e, 0x68, 0x1e, 0xdc, 0x1c, 0x46, 0xf4, 0x28, 0xc1, 0xa2,
-	0x7b, 0x78, 0x91, 0x8a, 0xfb, 0xdc, 0xd9, 0xd1, 0xb3, 0x2f, 0x78, 0xba, 0xff, 0xfc, 0xfb, 0x87,
-	0xc0, 0xf4, 0xc6, 0x45, 0x2a, 0x50, 0x7d, 0xac, 0x6f, 0x4b, 0x35, 0xec, 0x83, 0x7a, 0x80, 0xa9,
-	0xf7, 0x15, 0x65, 0x37, 0x06, 0xb5, 0xa2, 0x50, 0x57, 0xff, 0x17, 0x75, 0x9b, 0x3b, 0xb5, 0x97,
-	0x67, 0xbf, 0x7d, 0x45, 0xd9, 0x8d, 0x0a, 0x71, 0x9f, 0x3b, 0xdb, 0x1a, 0x3d, 0x1b, 0xa8, 0x48,
-	0xae, 0x05, 0x98, 0x8e, 0x9d, 0xe0, 0xef, 0x80, 0x3d, 0x36, 0xe7, 0x59, 0xaf, 0x47, 0x99, 0x30,
-	0x9d, 0xf2, 0xe1, 0x6d, 0xee, 0xd4, 0x0d, 0xe0, 0x8d, 0xd6, 0xdc, 0xe7, 0xce, 0x7b, 0x05, 0x84,
-	0xf1, 0x71, 0x51, 0xdd, 0x84, 0x35, 0xa6, 0xb0, 0x07, 0x6a, 0x24, 0xea, 0x1d, 0x9f, 0x7e, 0x64,
-	0xd2, 0x29, 0xab, 0x74, 0x3e, 0x5f, 0x94, 0x4e, 0xf5, 0xfc, 0xe2, 0xea, 0xf8, 0xf4, 0xa3, 0x51,
-	0x36, 0xa6, 0x0d, 0xa6, 0xa3, 0x14, 0x73, 0xa9, 0x6a, 0xa5, 0x4e, 0xe5, 0x02, 0x18, 0xd1, 0xeb,
-	0x62, 0xde, 0x55, 0x2d, 0x57, 0x69, 0x1f, 0xdc, 0xe6, 0x0e, 0xd0, 0x71, 0x7f, 0x8d, 0x79, 0x77,
-	0xb2, 0x3e, 0x9d, 0xe1, 0x1f, 0x70, 0x2a, 0xa2, 0x2c, 0x31, 0x91, 0x11, 0xd0, 0xce, 0xd2, 0x6a,
-	0x3c, 0xf9, 0x53, 0x33, 0xf9, 0xb5, 0xc7, 0x4e, 0xfe, 0xf4, 0xa1, 0xc9, 0x9f, 0x2e, 0x9a, 0xbc,
-	0xf6, 0x18, 0x13, 0x9f, 0x19, 0xe2, 0xfa, 0x63, 0x89, 0xcf, 0x1e, 0x22, 0x3e, 0x5b, 0x44, 0xd4,
-	0x1e, 0xb2, 0xbb, 0x0b, 0x35, 0x68, 0x59, 0x8f, 0xee, 0xee, 0x62, 0xf5, 0x8a, 0xdd, 0x3d, 0xd6,
-	0x6b, 0xd6, 0x10, 0x34, 0x7d, 0x9a, 0x72, 0x21, 0xc7, 0x52, 0xda, 0x8b, 0x89, 0x01, 0x56, 0x14,
-	0xf0, 0xd5, 0x22, 0xe0, 0x53, 0xf3, 0x63, 0xf0, 0x80, 0x7b, 0x91, 0xba, 0x35, 0x6b, 0xa4, 0xd1,
-	0x09, 0xb0, 0x7b, 0x44, 0x10, 0xc6, 0x3b, 0x19, 0x0b, 0x0d, 0x16, 0x28, 0x6c, 0x7b, 0x11, 0xd6,
-	0xf4, 0x79, 0xd1, 0xb5, 0x88, 0x6c, 0x4c, 0x0c, 0x34, 0x2e, 0x04, 0xf5, 0x48, 0xce, 0xa1, 0x93,
-	0xc5, 0x06, 0x56, 0x55, 0xb0, 0xcf, 0x16, 0xc1, 0xcc, 0xbe, 0x9d, 0x75, 0x2c, 0xa2, 0x36, 0x46,
-	0x6a, 0x0d, 0x62, 0x00, 0x26, 0x59, 0xc4, 0xbc, 0x30, 0xc6, 0x7e, 0x44, 0x98, 0x81, 0xd5, 0x14,
-	0xec, 0xe5, 0x22, 0xd8, 0x13, 0x0d, 0x9b, 0x77, 0x2e, 0x02, 0x6d, 0x69, 0xf2, 0x2b, 0x6d, 0xa1,
-	0x99, 0x18, 0xd4, 0x3a, 0x84, 0xc5, 0x51, 0x6a, 0x68, 0x1b, 0x8a, 0xf6, 0xe9, 0x22, 0x9a, 0xe9,
-	0xca, 0x69, 0xb7, 0xb9, 0xae, 0xd4, 0xca, 0x31, 0x22, 0xa6, 0x69, 0x40, 0x47, 0x88, 0xcd, 0x47,
-	0x23, 0xa6, 0xdd, 0xe6, 0x10, 0x5a, 0xa9, 0x11, 0x19, 0xd8, 0xc2, 0x8c, 0xd1, 0xb7, 0x85, 0xd2,
-	0x41, 0x45, 0x3a, 0x5f, 0x44, 0xda, 0xd5, 0xa4, 0x07, 0xbc, 0x8b, 0xc0, 0x4d, 0x65, 0x33, 0x53,
-	0x3c, 0x06, 0x60, 0xc8, 0xf0, 0xb0, 0x40, 0x6d, 0x3e, 0x7a, 0xc1, 0xe6, 0x9d, 0xe7, 0x16, 0x4c,
-	0x9a, 0xcc, 0x30, 0x07, 0xa0, 0x99, 0x10, 0x16, 0x12, 0x2f, 0x25, 0x82, 0xf7, 0xe2, 0x48, 0x18,
-	0xea, 0xf6, 0xa3, 0xf7, 0xdd, 0x43, 0xee, 0x45, 0x2e, 0x54, 0x46, 0x5f, 0x18, 0x9b, 0xf1, 0x3e,
-	0xe0, 0x5d, 0x9c, 0x86, 0x5d, 0x1c, 0x19, 0xe6, 0xce, 0xa3, 0xf7, 0xc1, 0xac, 0xe3, 0xdc, 0x3e,
-	0x18, 0xa9, 0xc7, 0x0d, 0xe3, 0xe3, 0xd4, 0xcf, 0x46, 0x0d, 0xf3, 0xde, 0xa3, 0x1b, 0x66, 0xda,
-	0x6d, 0xae, 0x61, 0xb4, 0x52, 0x21, 0x2e, 0xcb, 0x56, 0xdd, 0x6e, 0x5c, 0x96, 0xad, 0x86, 0x6d,
-	0x5f, 0x96, 0x2d, 0xdb, 0xde, 0xbc, 0x2c, 0x5b, 0x5b, 0x76, 0x13, 0x6d, 0x0c, 0x69, 0x4c, 0xbd,
-	0xfe, 0xc7, 0x3a, 0x04, 0xaa, 0x92, 0xb7, 0x98, 0x9b, 0x1f, 0x44, 0x54, 0xf7, 0xb1, 0xc0, 0xf1,
-	0x90, 0x9b, 0x92, 0x21, 0x5b, 0x17, 0x72, 0xea, 0x58, 0x3e, 0x02, 0xab, 0x6f, 0x84, 0xbc, 0xa7,
-	0xd9, 0xa0, 0x74, 0x43, 0x86, 0xfa, 0x6a, 0x81, 0xe4, 0x27, 0x6c, 0x82, 0xd5, 0x3e, 0x8e, 0x33,
-	0x7d, 0xe1, 0xab, 0x20, 0x2d, 0xb8, 0x57, 0xa0, 0x71, 0xcd, 0x70, 0xca, 0xb1, 0x2f, 0x22, 0x9a,
-	0xbe, 0xa6, 0x21, 0x87, 0x10, 0x94, 0xd5, 0x59, 0xa7, 0x7d, 0xd5, 0x37, 0xfc, 0x39, 0x28, 0xc7,
-	0x34, 0xe4, 0xad, 0x95, 0xfd, 0xd2, 0x41, 0xf5, 0x64, 0x7b, 0xfe, 0xca, 0xf5, 0x9a, 0x86, 0x48,
-	0x99, 0xb8, 0xff, 0x58, 0x01, 0xa5, 0xd7, 0x34, 0x84, 0x2d, 0xb0, 0x8e, 0x83, 0x80, 0x11, 0xce,
-	0x4d, 0xa4, 0x91, 0x08, 0x77, 0xc0, 0x9a, 0xa0, 0xbd, 0xc8, 0xd7, 0xe1, 0x2a, 0xc8, 0x48, 0x12,
-	0x1c, 0x60, 0x81, 0xd5, 0x55, 0xa1, 0x86, 0xd4, 0x37, 0x3c, 0x01, 0x35, 0x95, 0x99, 0x97, 0x66,
-	0x49, 0x87, 0x30, 0x75, 0xe2, 0x97, 0xdb, 0x8d, 0xbb, 0xdc, 0xa9, 0xaa, 0xf1, 0x2f, 0xd4, 0x30,
-	0x9a, 0x16, 0xe0, 0x07, 0x60, 0x5d, 0x0c, 0xa6, 0xcf, 0xeb, 0xad, 0xbb, 0xdc, 0x69, 0x88, 0x49,
-	0x9a, 0xf2, 0x38, 0x46, 0x6b, 0x62, 0xa0, 0x8e, 0xe5, 0x23, 0x60, 0x89, 0x81, 0x17, 0xa5, 0x01,
-	0x19, 0xa8, 0x23, 0xb9, 0xdc, 0x6e, 0xde, 0xe5, 0x8e, 0x3d, 0x65, 0x7e, 0x21, 0x75, 0x68, 0x5d,
-	0x0c, 0xd4, 0x07, 0xfc, 0x00, 0x00, 0x3d, 0x25, 0x45, 0xd0, 0x67, 0xea, 0xc6, 0x5d, 0xee, 0x54,
-	0xd4, 0xa8, 0x8a, 0x3d, 0xf9, 0x84, 0x2e, 0x58, 0xd5, 0xb1, 0x2d, 0x15, 0xbb, 0x76, 0x97, 0x3b,
-	0x56, 0x4c, 0x43, 0x1d, 0x53, 0xab, 0x64, 0xa9, 0x18, 0x49, 0x68, 0x9f, 0x04, 0xea, 0xf0, 0xb2,
-	0xd0, 0x48, 0x74, 0xff, 0xbc, 0x02, 0xac, 0xeb, 0x01, 0x22, 0x3c, 0x8b, 0x05, 0x7c, 0x05, 0x6c,
-	0x9f, 0xa6, 0x82, 0x61, 0x5f, 0x78, 0x33, 0xa5, 0x6d, 0x3f, 0x9d, 0x1c, 0x2e, 0x45, 0x0b, 0x17,
-	0x35, 0x46, 0x43, 0x67, 0xa6, 0xfe, 0x4d, 0xb0, 0xda, 0x89, 0x29, 0x4d, 0x54, 0x27, 0xd4, 0x90,
-	0x16, 0x20, 0x52, 0x55, 0x53, 0xab, 0x5c, 0x52, 0x17, 0xeb, 0x9f, 0xce, 0xaf, 0x72, 0xa1, 0x55,
-	0xda, 0x3b, 0xe6, 0x72, 0x5d, 0xd7, 0x6c, 0xe3, 0xef, 0xca, 0xda, 0xaa, 0x56, 0xb2, 0x41, 0x89,
-	0x11, 0xa1, 0x16, 0xad, 0x86, 0xe4, 0x27, 0xdc, 0x05, 0x16, 0x23, 0x7d, 0xc2, 0x04, 0x09, 0xd4,
-	0xe2, 0x58, 0x68, 0x2c, 0xc3, 0x27, 0xc0, 0x0a, 0x31, 0xf7, 0x32, 0x4e, 0x02, 0xbd, 0x12, 0x68,
-	0x3d, 0xc4, 0xfc, 0x4b, 0x4e, 0x82, 0xe7, 0xe5, 0x3f, 0x7e, 0xe3, 0x2c, 0xb9, 0x18, 0x54, 0xcf,
-	0x7c, 0x9f, 0x70, 0x7e, 0x9d, 0xf5, 0x62, 0xb2, 0xa0, 0xc3, 0x4e, 0x40, 0x8d, 0x0b, 0xca, 0x70,
-	0x48, 0xbc, 0x1b, 0x32, 0x34, 0x7d, 0xa6, 0xbb, 0xc6, 0x8c, 0xff, 0x86, 0x0c, 0x39, 0x9a, 0x16,
-	0x0c, 0xe2, 0x9b, 0x32, 0xa8, 0x5e, 0x33, 0xec, 0x13, 0x73, 0x5d, 0x97, 0xbd, 0x2a, 0x45, 0x66,
-	0x10, 0x46, 0x92, 0x6c, 0x11, 0x25, 0x84, 0x66, 0xc2, 0xec, 0xa7, 0x91, 0x28, 0x3d, 0x18, 0x21,
-	0x03, 0xe2, 0xab, 0x32, 0x96, 0x91, 0x91, 0xe0, 0x29, 0xd8, 0x08, 0x22, 0xae, 0x5e, 0x47, 0x5c,
-	0x60, 0xff, 0x46, 0xa7, 0xdf, 0xb6, 0xef, 0x72, 0xa7, 0x66, 0x14, 0x6f, 0xe4, 0x38, 0x9a, 0x91,
-	0xe0, 0x27, 0xa0, 0x31, 0x71, 0x53, 0xb3, 0xd5, 0x4f, 0x91, 0x36, 0xbc, 0xcb, 0x9d, 0xfa, 0xd8,
-	0x54, 0x69, 0x50, 0x41, 0x96, 0x2b, 0x1d, 0x90, 0x4e, 0x16, 0xaa, 0xe6, 0xb3, 0x90, 0x16, 0xe4,
-	0x68, 0x1c, 0x25, 0x91, 0x50, 0xcd, 0xb6, 0x8a, 0xb4, 0x00, 0x3f, 0x01, 0x15, 0xda, 0x27, 0x8c,
-	0x45, 0x01, 0xe1, 0xea, 0x32, 0xf3, 0x63, 0x4f, 0x2b, 0x34, 0xb1, 0x97, 0xc9, 0x99, 0x97, 0x5f,
-	0x42, 0x12, 0xca, 0x86, 0xea, 0x82, 0x62, 0x92, 0xd3, 0x8a, 0xcf, 0xd5, 0x38, 0x9a, 0x91, 0x60,
-	0x1b, 0x40, 0xe3, 0xc6, 0x88, 0xc8, 0x58, 0xea, 0xa9, 0xfd, 0x5f, 0x53, 0xbe, 0x6a, 0x17, 0x6a,
-	0x2d, 0x52, 0xca, 0x97, 0x58, 0x60, 0x34, 0x37, 0x02, 0x3f, 0x05, 0x50, 0xaf, 0x89, 0xf7, 0x35,
-	0xa7, 0xe3, 0xb7, 0xa1, 0xbe, 0x45, 0x28, 0xbe, 0xd6, 0x9a, 0x39, 0xdb, 0x5a, 0xba, 0xe4, 0xd4,
-	0x64, 0x71, 0x59, 0xb6, 0xca, 0xf6, 0xea, 0x65, 0xd9, 0x5a, 0xb7, 0xad, 0x71, 0xfd, 0x4c, 0x16,
-	0x68, 0x6b, 0x24, 0x4f, 0x4d, 0xaf, 0xfd, 0xd9, 0x77, 0xb7, 0x7b, 0xcb, 0xdf, 0xdf, 0xee, 0x2d,
-	0xff, 0xe7, 0x76, 0x6f, 0xf9, 0x2f, 0xef, 0xf6, 0x96, 0xbe, 0x7f, 0xb7, 0xb7, 0xf4, 0xaf, 0x77,
-	0x7b, 0x4b, 0xbf, 0xff, 0x59, 0x18, 0x89, 0x6e, 0xd6, 0x39, 0xf4, 0x69, 0x22, 0x9f, 0x80, 0x94,
-	0x1f, 0x15, 0x1f, 0x85, 0x62, 0xd8, 0x23, 0xbc, 0xb3, 0xa6, 0x1e, 0xf2, 0x1f, 0xff, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0x9d, 0x1a, 0x02, 0x8b, 0x3c, 0x10, 0x00, 0x00,
+	// 2089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x4b, 0x6f, 0x1b, 0xc7,
+	0x1d, 0x37, 0x45, 0x4a, 0x24, 0x87, 0x14, 0xb9, 0x1a, 0x51, 0x32, 0x6d, 0xa3, 0x5a, 0x65, 0x51,
+	0x24, 0x6a, 0x1e, 0x52, 0xec, 0x40, 0xad, 0xe1, 0xa0, 0x46, 0x4c, 0x59, 0x76, 0xa4, 0x3a, 0xa9,
+	0x30, 0x52, 0x50, 0xa0, 0x97, 0xc5, 0x70, 0x77, 0x4c, 0x6e, 0xb4, 0xbb, 0x43, 0xec, 0xcc, 0xd2,
+	0xa4, 0x3f, 0x41, 0xd1, 0x5e, 0xf2, 0x11, 0x72, 0x2c, 0x7a, 0xca, 0xa1, 0x9f, 0xa0, 0xa7, 0xa0,
+	0xa7, 0xa0, 0xa7, 0xa2, 0x87, 0x6d, 0x21, 0x1f, 0x02, 0xa8, 0x37, 0xdd, 0x7a, 0x2b, 0xe6, 0xb1,
+	0x4b, 0x72, 0xa9, 0xd0, 0xba, 0x90, 0xf3, 0x7f, 0xcd, 0x6f, 0xfe, 0xaf, 0x99, 0xd9, 0x01, 0x77,
+	0x09, 0xef, 0x93, 0x28, 0xf0, 0x42, 0xbe, 0x47, 0x86, 0xc1, 0xde, 0xf0, 0xbe, 0xf8, 0xdb, 0x1d,
+	0x44, 0x94, 0x53, 0x68, 0x64, 0xb2, 0x5d, 0xc1, 0x1c, 0xde, 0xbf, 0xdb, 0xea, 0xd1, 0x1e, 0x95,
+	0xc2, 0x3d, 0x31, 0x52, 0x7a, 0x77, 0xd7, 0x70, 0xe0, 0x85, 0x74, 0x4f, 0xfe, 0x6a, 0xd6, 0x1d,
+	0x87, 0xb2, 0x80, 0x32, 0x5b, 0xe9, 0x2a, 0x42, 0x89, 0xac, 0xbf, 0x2d, 0x83, 0x95, 0x13, 0x1c,
+	0xe1, 0x80, 0xc1, 0xfb, 0xa0, 0x4a, 0x86, 0x81, 0xed, 0x92, 0x90, 0x06, 0xed, 0xc2, 0x76, 0x61,
+	0xa7, 0xda, 0x69, 0x5d, 0x25, 0xa6, 0x31, 0xc6, 0x81, 0xff, 0xc8, 0xca, 0x44, 0x16, 0xaa, 0x90,
+	0x61, 0xf0, 0x54, 0x0c, 0xe1, 0xaf, 0xc1, 0x2a, 0x09, 0x71, 0xd7, 0x27, 0xb6, 0x13, 0x11, 0xcc,
+	0x49, 0x7b, 0x69, 0xbb, 0xb0, 0x53, 0xe9, 0xb4, 0xaf, 0x12, 0xb3, 0xa5, 0xcd, 0xa6, 0xc5, 0x16,
+	0xaa, 0x2b, 0xfa, 0x40, 0x92, 0xf0, 0x57, 0xa0, 0x96, 0xca, 0xb1, 0xef, 0xb7, 0x8b, 0xd2, 0x78,
+	0xf3, 0x2a, 0x31, 0xe1, 0xac, 0x31, 0xf6, 0x7d, 0x0b, 0x01, 0x6d, 0x8a, 0x7d, 0x1f, 0x3e, 0x01,
+	0x80, 0x8c, 0x78, 0x84, 0x6d, 0xe2, 0x0d, 0x58, 0xbb, 0xb4, 0x5d, 0xdc, 0x29, 0x76, 0xac, 0x8b,
+	0xc4, 0xac, 0x1e, 0x0a, 0xee, 0xe1, 0xd1, 0x09, 0xbb, 0x4a, 0xcc, 0x35, 0x3d, 0x49, 0xa6, 0x68,
+	0xa1, 0xaa, 0x24, 0x0e, 0xbd, 0x01, 0x83, 0x5d, 0x50, 0x77, 0xfa, 0xd8, 0x0b, 0x6d, 0x87, 0x86,
+	0x2f, 0xbd, 0x5e, 0x7b, 0x79, 0xbb, 0xb0, 0x53, 0x7b, 0xf0, 0xb3, 0xdd, 0x7c, 0x94, 0x77, 0x0f,
+	0x84, 0xd6, 0x81, 0x54, 0xea, 0x6c, 0x7f, 0x9f, 0x98, 0xb7, 0xae, 0x12, 0x73, 0x5d, 0x4d, 0x3d,
+	0x3d, 0x81, 0xf5, 0xe7, 0x1f, 0xbf, 0x7b, 0xbf, 0x80, 0x6a, 0xce, 0x44, 0x1d, 0x3e, 0x00, 0x1b,
+	0xd8, 0xf7, 0xe9, 0x2b, 0x3b, 0x0e, 0x45, 0xb4, 0x89, 0xc3, 0x89, 0x6b, 0xf3, 0x11, 0x6b, 0xaf,
+	0x08, 0x4f, 0xd1, 0xba, 0x14, 0x7e, 0x35, 0x91, 0x9d, 0x8d, 0x18, 0xb4, 0xc0, 0x6a, 0x80, 0x47,
+	0xb6, 0x43, 0x5d, 0x62, 0x33, 0xef, 0x35, 0x69, 0x97, 0xb7, 0x0b, 0x3b, 0x25, 0x54, 0x0b, 0xf0,
+	0xe8, 0x80, 0xba, 0xe4, 0xd4, 0x7b, 0x4d, 0xe0, 0x07, 0x00, 0x0a, 0x1d, 0x2f, 0xf4, 0xf8, 0x94,
+	0x62, 0x45, 0x2a, 0x36, 0x03, 0x3c, 0x3a, 0x0a, 0x3d, 0x9e, 0x29, 0x3f, 0x02, 0x77, 0xba, 0x3e,
+	0x75, 0xce, 0x89, 0x2b, 0x56, 0xca, 0x23, 0xec, 0x70, 0x95, 0x0e, 0x1a, 0xb1, 0x76, 0x75, 0xbb,
+	0xb8, 0x53, 0x45, 0xb7, 0xb5, 0xc2, 0x81, 0x96, 0x1f, 0x68, 0x31, 0x7c, 0x0f, 0x34, 0x33, 0x5b,
+	0xec, 0xfb, 0x84, 0xb0, 0x36, 0x90, 0x16, 0x8d, 0xd4, 0x42, 0x71, 0xe1, 0x2f, 0x41, 0x5b, 0xac,
+	0x88, 0x91, 0xd0, 0x25, 0x91, 0x70, 0xd1, 0x1e, 0x90, 0xc8, 0x96, 0x4a, 0xed, 0x9a, 0x5c, 0x57,
+	0x2b, 0xc0, 0xa3, 0x53, 0x29, 0x3e, 0x1b, 0xb1, 0x13, 0x12, 0x75, 0x84, 0x0c, 0xbe, 0x07, 0x0c,
+	0x61, 0xc7, 0x47, 0x76, 0x0f, 0x33, 0xdb, 0xf7, 0x02, 0x8f, 0xb7, 0xeb, 0x52, 0x5f, 0x44, 0xe1,
+	0x6c, 0xf4, 0x1c, 0xb3, 0x17, 0x82, 0xf9, 0xe8, 0xde, 0x1f, 0x7f, 0xfc, 0xee, 0xfd, 0xcd, 0x49,
+	0x7b, 0x8c, 0x64, 0x83, 0xa8, 0xca, 0xb5, 0xfe, 0x6b, 0x80, 0xda, 0x54, 0x9a, 0xe0, 0xd7, 0xa0,
+	0xd9, 0xa7, 0x01, 0x61, 0x9c, 0x60, 0x57, 0x2f, 0x42, 0xd5, 0xf3, 0x93, 0x7f, 0x25, 0xe6, 0x86,
+	0xaa, 0x7f, 0xe6, 0x9e, 0xef, 0x7a, 0x74, 0x2f, 0xc0, 0xbc, 0xbf, 0x7b, 0x14, 0xf2, 0xab, 0xc4,
+	0xdc, 0x54, 0x49, 0xcd, 0x59, 0x5a, 0xff, 0xf8, 0xeb, 0x47, 0x40, 0xb7, 0xcc, 0x51, 0xc8, 0x51,
+	0x23, 0x93, 0x2b, 0x0f, 0x86, 0xa0, 0xe1, 0x62, 0x6a, 0xbf, 0xa4, 0xd1, 0xb9, 0x86, 0x5a, 0x92,
+	0x50, 0x27, 0x3f, 0x09, 0x75, 0x91, 0x98, 0xf5, 0xa7, 0x4f, 0x7e, 0xfb, 0x8c, 0x46, 0xe7, 0x72,
+	0x8a, 0xab, 0xc4, 0xdc, 0x50, 0xd0, 0xb3, 0x13, 0xe5, 0x91, 0xeb, 0x2e, 0xa6, 0x99, 0x11, 0xfc,
+	0x1d, 0x30, 0x32, 0x75, 0x16, 0x0f, 0x06, 0x34, 0xe2, 0xba, 0x81, 0x3e, 0xba, 0x48, 0xcc, 0x86,
+	0x06, 0x38, 0x55, 0x92, 0xab, 0xc4, 0xbc, 0x9d, 0x83, 0xd0, 0x36, 0x16, 0x6a, 0xe8, 0x69, 0xb5,
+	0x2a, 0x1c, 0x80, 0x3a, 0xf1, 0x06, 0xf7, 0xf7, 0x3f, 0xd6, 0xee, 0x94, 0xa4, 0x3b, 0x5f, 0x2c,
+	0x72, 0xa7, 0x76, 0x78, 0x74, 0x72, 0x7f, 0xff, 0xe3, 0xd4, 0x1b, 0xdd, 0x1d, 0xd3, 0xb3, 0xe4,
+	0x7d, 0xa9, 0x29, 0xa1, 0x72, 0xe5, 0x08, 0x68, 0xd2, 0xee, 0x63, 0xd6, 0x97, 0x9d, 0x58, 0xed,
+	0xec, 0x5c, 0x24, 0x26, 0x50, 0xf3, 0x7e, 0x8e, 0x59, 0x7f, 0x92, 0x9f, 0xee, 0xf8, 0x35, 0x0e,
+	0xb9, 0x17, 0x07, 0x7a, 0x66, 0x04, 0x94, 0xb1, 0xd0, 0xca, 0x16, 0xbf, 0xaf, 0x17, 0xbf, 0x72,
+	0xd3, 0xc5, 0xef, 0x5f, 0xb7, 0xf8, 0xfd, 0x45, 0x8b, 0x57, 0x16, 0x19, 0xe2, 0x43, 0x8d, 0x58,
+	0xbe, 0x29, 0xe2, 0xc3, 0xeb, 0x10, 0x1f, 0x2e, 0x42, 0x54, 0x16, 0xa2, 0xba, 0x73, 0x31, 0x68,
+	0x57, 0x6e, 0x5c, 0xdd, 0xf9, 0xe8, 0xe5, 0xab, 0x3b, 0x93, 0x2b, 0xac, 0x31, 0x68, 0x39, 0x34,
+	0x64, 0x5c, 0xf0, 0x42, 0x3a, 0xf0, 0x89, 0x06, 0xac, 0x4a, 0xc0, 0x67, 0x8b, 0x00, 0xef, 0xe9,
+	0x3d, 0xf2, 0x1a, 0xf3, 0x3c, 0xea, 0xfa, 0xac, 0x92, 0x82, 0x0e, 0x80, 0x31, 0x20, 0x9c, 0x44,
+	0xac, 0x1b, 0x47, 0x3d, 0x0d, 0x0b, 0x24, 0x6c, 0x67, 0x11, 0xac, 0xae, 0xf3, 0xbc, 0x69, 0x1e,
+	0xb2, 0x39, 0x51, 0x50, 0x70, 0x3d, 0xd0, 0xf0, 0xc4, 0x1a, 0xba, 0xb1, 0x3f, 0xb5, 0x6f, 0x55,
+	0x3b, 0x9f, 0x2d, 0x02, 0xd3, 0x7d, 0x3b, 0x6b, 0x98, 0x87, 0x5a, 0x4d, 0xc5, 0x0a, 0x28, 0x02,
+	0x30, 0x88, 0xbd, 0xc8, 0xee, 0xf9, 0xd8, 0xf1, 0xb2, 0x4d, 0xb2, 0x2e, 0xc1, 0x9e, 0x2e, 0x02,
+	0xbb, 0xa3, 0xc0, 0xe6, 0x8d, 0xf3, 0x80, 0x86, 0x50, 0x79, 0xae, 0x34, 0x14, 0x26, 0x06, 0xf5,
+	0x2e, 0x89, 0x7c, 0x2f, 0xd4, 0x68, 0xab, 0x12, 0xed, 0xf1, 0x22, 0x34, 0x5d, 0x95, 0xd3, 0x66,
+	0x73, 0x55, 0xa9, 0x84, 0x19, 0x84, 0x4f, 0x43, 0x97, 0xa6, 0x10, 0x6b, 0x37, 0x86, 0x98, 0x36,
+	0x9b, 0x83, 0x50, 0x42, 0x05, 0x11, 0x83, 0x75, 0x1c, 0x45, 0xf4, 0x55, 0x2e, 0x74, 0x50, 0x22,
+	0x1d, 0x2e, 0x42, 0xba, 0xab, 0x90, 0xae, 0xb1, 0xce, 0x03, 0xae, 0x49, 0x9d, 0x99, 0xe0, 0x45,
+	0x00, 0xf6, 0x22, 0x3c, 0xce, 0xa1, 0xb6, 0x6e, 0x9c, 0xb0, 0x79, 0xe3, 0xb9, 0x84, 0x09, 0x95,
+	0x19, 0xcc, 0x11, 0x68, 0x05, 0x24, 0xea, 0x11, 0x3b, 0x24, 0x9c, 0x0d, 0x7c, 0x8f, 0x6b, 0xd4,
+	0x8d, 0x1b, 0xf7, 0xdd, 0x75, 0xe6, 0x79, 0x5c, 0x28, 0x95, 0xbe, 0xd4, 0x3a, 0x59, 0x1f, 0xb0,
+	0x3e, 0x0e, 0x7b, 0x7d, 0xec, 0x69, 0xcc, 0xcd, 0x1b, 0xf7, 0xc1, 0xac, 0xe1, 0x5c, 0x1f, 0xa4,
+	0xe2, 0xac, 0x60, 0x1c, 0x1c, 0x3a, 0x71, 0x5a, 0x30, 0xb7, 0x6f, 0x5c, 0x30, 0xd3, 0x66, 0x73,
+	0x05, 0xa3, 0x84, 0x12, 0xe2, 0xb8, 0x54, 0x69, 0x18, 0xcd, 0xe3, 0x52, 0xa5, 0x69, 0x18, 0xc7,
+	0xa5, 0x8a, 0x61, 0xac, 0x1d, 0x97, 0x2a, 0xeb, 0x46, 0x0b, 0xad, 0x8e, 0xa9, 0x4f, 0xed, 0xe1,
+	0x27, 0x6a, 0x0a, 0x54, 0x23, 0xaf, 0x30, 0xd3, 0x1b, 0x22, 0x6a, 0x38, 0x98, 0x63, 0x7f, 0xcc,
+	0x74, 0xc8, 0x90, 0xa1, 0x02, 0x39, 0x75, 0x2c, 0xef, 0x81, 0xe5, 0x53, 0x2e, 0xae, 0xaf, 0x06,
+	0x28, 0x9e, 0x93, 0xb1, 0xba, 0x5a, 0x20, 0x31, 0x84, 0x2d, 0xb0, 0x3c, 0xc4, 0x7e, 0xac, 0xee,
+	0xc1, 0x55, 0xa4, 0x08, 0xeb, 0x04, 0x34, 0xcf, 0x22, 0x1c, 0x32, 0xec, 0x70, 0x8f, 0x86, 0x2f,
+	0x68, 0x8f, 0x41, 0x08, 0x4a, 0xf2, 0xac, 0x53, 0xb6, 0x72, 0x0c, 0x7f, 0x01, 0x4a, 0x3e, 0xed,
+	0xb1, 0xf6, 0xd2, 0x76, 0x71, 0xa7, 0xf6, 0x60, 0x63, 0xfe, 0x26, 0xfa, 0x82, 0xf6, 0x90, 0x54,
+	0xb1, 0xfe, 0xbe, 0x04, 0x8a, 0x2f, 0x68, 0x0f, 0xb6, 0x41, 0x19, 0xbb, 0x6e, 0x44, 0x18, 0xd3,
+	0x33, 0xa5, 0x24, 0xdc, 0x04, 0x2b, 0x9c, 0x0e, 0x3c, 0x47, 0x4d, 0x57, 0x45, 0x9a, 0x12, 0xc0,
+	0x2e, 0xe6, 0x58, 0x5e, 0x15, 0xea, 0x48, 0x8e, 0xe1, 0x03, 0x50, 0x97, 0x9e, 0xd9, 0x61, 0x1c,
+	0x74, 0x49, 0x24, 0x4f, 0xfc, 0x52, 0xa7, 0x79, 0x99, 0x98, 0x35, 0xc9, 0xff, 0x52, 0xb2, 0xd1,
+	0x34, 0x01, 0x3f, 0x04, 0x65, 0x3e, 0x9a, 0x3e, 0xaf, 0xd7, 0x2f, 0x13, 0xb3, 0xc9, 0x27, 0x6e,
+	0x8a, 0xe3, 0x18, 0xad, 0xf0, 0x91, 0xf8, 0x87, 0x7b, 0xa0, 0xc2, 0xc5, 0x7d, 0xd5, 0x25, 0x23,
+	0x79, 0x24, 0x97, 0x3a, 0xad, 0xcb, 0xc4, 0x34, 0xa6, 0xd4, 0x8f, 0x84, 0x0c, 0x95, 0xf9, 0x48,
+	0x0e, 0xe0, 0x87, 0x00, 0xa8, 0x25, 0x49, 0x04, 0x75, 0xa6, 0xae, 0x5e, 0x26, 0x66, 0x55, 0x72,
+	0xe5, 0xdc, 0x93, 0x21, 0xb4, 0xc0, 0xb2, 0x9a, 0x5b, 0x5e, 0x81, 0x3b, 0xf5, 0xcb, 0xc4, 0xac,
+	0xf8, 0xb4, 0xa7, 0xe6, 0x54, 0x22, 0x11, 0xaa, 0x88, 0x04, 0x74, 0x48, 0x5c, 0x79, 0x78, 0x55,
+	0x50, 0x4a, 0x5a, 0x7f, 0x5a, 0x02, 0x95, 0xb3, 0x11, 0x22, 0x2c, 0xf6, 0x39, 0x7c, 0x06, 0x8c,
+	0xec, 0x96, 0x3c, 0x13, 0xda, 0xce, 0xbd, 0xc9, 0xe1, 0x92, 0xd7, 0xb0, 0x50, 0x33, 0x65, 0x3d,
+	0xd1, 0xf1, 0x6f, 0x81, 0xe5, 0xae, 0x4f, 0x69, 0x20, 0x2b, 0xa1, 0x8e, 0x14, 0x01, 0x91, 0x8c,
+	0x9a, 0xcc, 0x72, 0x51, 0x7e, 0x6f, 0xbc, 0x33, 0x9f, 0xe5, 0x5c, 0xa9, 0x74, 0x36, 0xf5, 0x37,
+	0x47, 0x43, 0x61, 0x6b, 0x7b, 0x4b, 0xc4, 0x56, 0x96, 0x92, 0x01, 0x8a, 0x11, 0xe1, 0x32, 0x69,
+	0x75, 0x24, 0x86, 0xf0, 0x2e, 0xa8, 0x44, 0x64, 0x48, 0x22, 0x4e, 0x5c, 0x99, 0x9c, 0x0a, 0xca,
+	0x68, 0x78, 0x07, 0x54, 0xc4, 0x4d, 0x3b, 0x66, 0xc4, 0x55, 0x99, 0x40, 0xe5, 0x1e, 0x66, 0x5f,
+	0x31, 0xe2, 0x3e, 0x2a, 0xfd, 0xe1, 0x5b, 0xf3, 0x96, 0xf5, 0x4d, 0x11, 0x54, 0x45, 0x34, 0x1c,
+	0xe2, 0x0d, 0xf8, 0x74, 0x9a, 0x0b, 0x6f, 0x4f, 0x73, 0xbe, 0x90, 0x96, 0x6e, 0x50, 0x48, 0xd3,
+	0xa5, 0x51, 0xbc, 0x49, 0x69, 0x6c, 0x82, 0x15, 0xc6, 0x31, 0x8f, 0x99, 0xaa, 0x53, 0xa4, 0x29,
+	0xf8, 0xee, 0x94, 0x67, 0xcb, 0x72, 0xa2, 0xda, 0x65, 0x62, 0xa6, 0xde, 0x65, 0x6e, 0xc2, 0x43,
+	0xb0, 0xee, 0xc4, 0x41, 0xec, 0x63, 0xee, 0x0d, 0x89, 0x3d, 0x1b, 0x8c, 0xce, 0xc6, 0x65, 0x62,
+	0xae, 0x4d, 0xc4, 0xcf, 0xb5, 0xf1, 0x3c, 0x0b, 0x3e, 0xbe, 0xa6, 0x50, 0xca, 0x93, 0x10, 0xe5,
+	0xea, 0x61, 0xbe, 0x40, 0xd2, 0x6e, 0xaf, 0xbc, 0xbd, 0xdb, 0x23, 0x50, 0x3f, 0x90, 0x3b, 0xdc,
+	0xd9, 0xe8, 0x28, 0x7c, 0x49, 0xe1, 0xcf, 0x41, 0x43, 0x7f, 0xd0, 0xcf, 0xe4, 0x06, 0xd5, 0x1d,
+	0xad, 0x25, 0x93, 0xf1, 0x4e, 0x9a, 0x8c, 0x3e, 0xf1, 0x7a, 0x7d, 0xae, 0x92, 0xa1, 0x63, 0xff,
+	0xb9, 0x64, 0xc1, 0x3b, 0xf9, 0xd8, 0x67, 0x51, 0xb6, 0xfa, 0xc0, 0x98, 0xf9, 0x1a, 0xf4, 0x68,
+	0x28, 0x5a, 0x48, 0x7f, 0x38, 0xa6, 0xbb, 0x8d, 0x26, 0xe1, 0xed, 0x49, 0x99, 0xa8, 0x9d, 0x6f,
+	0x85, 0x5f, 0xbf, 0x88, 0xe2, 0xdc, 0x22, 0xac, 0xff, 0x15, 0x40, 0xed, 0x89, 0xe3, 0xd0, 0x38,
+	0xe4, 0x4f, 0xe3, 0x60, 0xb0, 0x60, 0x4f, 0x6b, 0x83, 0x72, 0x17, 0xfb, 0x38, 0x74, 0xd2, 0xfd,
+	0x35, 0x25, 0x45, 0xb7, 0x85, 0x54, 0xf0, 0xd5, 0xfc, 0x8a, 0x80, 0xf7, 0x40, 0x55, 0x7e, 0x1d,
+	0xcb, 0x75, 0xc9, 0xcf, 0x18, 0x54, 0x11, 0x0c, 0xb9, 0x32, 0x08, 0x4a, 0x62, 0x2c, 0x4b, 0xa5,
+	0x8e, 0xe4, 0x18, 0x76, 0x40, 0x99, 0x71, 0x1a, 0xe1, 0x1e, 0x69, 0xaf, 0xc8, 0xb4, 0xdc, 0x9e,
+	0x4f, 0x8b, 0xdc, 0xfa, 0x3b, 0x4d, 0xd1, 0x94, 0x7f, 0xf9, 0xb7, 0x59, 0x3e, 0x55, 0xfa, 0x28,
+	0x35, 0x84, 0x1f, 0x80, 0x35, 0x3d, 0xb4, 0x79, 0x14, 0x87, 0x0e, 0x16, 0x5d, 0x58, 0x96, 0x5d,
+	0x68, 0x68, 0xc1, 0x59, 0xca, 0xb7, 0xb0, 0x74, 0x9d, 0x30, 0x76, 0x16, 0x0f, 0x7c, 0xb2, 0xc0,
+	0xf5, 0x07, 0xa0, 0x9e, 0xce, 0x7a, 0x4e, 0xc6, 0x7a, 0x53, 0x57, 0x9d, 0xa5, 0xf9, 0xbf, 0x21,
+	0x63, 0x86, 0xa6, 0x09, 0xdd, 0xcf, 0xdf, 0x96, 0x40, 0xed, 0x2c, 0xc2, 0x0e, 0xd1, 0xdf, 0xc6,
+	0xe2, 0x60, 0x10, 0x64, 0x9a, 0x43, 0x4d, 0x09, 0x6c, 0xee, 0x05, 0x84, 0xc6, 0x3c, 0x0d, 0xae,
+	0x26, 0x85, 0x45, 0x44, 0xc8, 0x88, 0x38, 0x3a, 0xba, 0x9a, 0x82, 0xfb, 0x60, 0xd5, 0xf5, 0x98,
+	0x7c, 0xa1, 0x61, 0x1c, 0x3b, 0xe7, 0x6a, 0xaf, 0xe9, 0x18, 0x97, 0x89, 0x59, 0xd7, 0x82, 0x53,
+	0xc1, 0x47, 0x33, 0x14, 0xfc, 0x14, 0x34, 0x27, 0x66, 0x69, 0xb0, 0x85, 0x21, 0xbc, 0x4c, 0xcc,
+	0x46, 0xa6, 0xaa, 0xc2, 0x9a, 0xa3, 0x45, 0xa2, 0x5d, 0xd2, 0x8d, 0x7b, 0x72, 0xa7, 0xaf, 0x20,
+	0x45, 0x08, 0xae, 0x7a, 0x3a, 0x10, 0x3b, 0xfb, 0x32, 0x52, 0x04, 0xfc, 0x14, 0x54, 0xe9, 0x90,
+	0x44, 0x91, 0xe7, 0xca, 0x67, 0x8b, 0xb7, 0x3f, 0xef, 0xa0, 0x89, 0xbe, 0x70, 0x4e, 0xbf, 0x3e,
+	0x05, 0x24, 0xa0, 0xd1, 0xb8, 0x5d, 0x9b, 0x38, 0xa7, 0x04, 0x5f, 0x48, 0x3e, 0x9a, 0xa1, 0x60,
+	0x07, 0x40, 0x6d, 0x16, 0x11, 0x1e, 0x47, 0xa1, 0x2d, 0x0f, 0xdb, 0xba, 0xb4, 0x95, 0xfb, 0x9a,
+	0x92, 0x22, 0x29, 0x7c, 0x8a, 0x39, 0x46, 0x73, 0x1c, 0xf8, 0x18, 0x40, 0x95, 0x13, 0xfb, 0x6b,
+	0x46, 0xb3, 0xf7, 0x29, 0x75, 0x65, 0x97, 0xf8, 0x4a, 0xaa, 0xd7, 0x6c, 0x28, 0xea, 0x98, 0x51,
+	0xed, 0xc5, 0x71, 0xa9, 0x52, 0x32, 0x96, 0x8f, 0x4b, 0x95, 0xb2, 0x51, 0xc9, 0xe2, 0xa7, 0xbd,
+	0x40, 0xeb, 0x29, 0x3d, 0xb5, 0xbc, 0xce, 0x67, 0xdf, 0x5f, 0x6c, 0x15, 0x7e, 0xb8, 0xd8, 0x2a,
+	0xfc, 0xe7, 0x62, 0xab, 0xf0, 0xcd, 0x9b, 0xad, 0x5b, 0x3f, 0xbc, 0xd9, 0xba, 0xf5, 0xcf, 0x37,
+	0x5b, 0xb7, 0x7e, 0xff, 0x6e, 0xcf, 0xe3, 0xfd, 0xb8, 0xbb, 0xeb, 0xd0, 0x40, 0xbc, 0xb7, 0x50,
+	0xb6, 0x97, 0x7f, 0x81, 0xe1, 0xe3, 0x01, 0x61, 0xdd, 0x15, 0xf9, 0x98, 0xf8, 0xc9, 0xff, 0x07,
+	0x00, 0xd3, 0xe8, 0x6b, 0xf7, 0xc0, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -818,6 +1252,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTxGasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxTxGasLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxSenderTxsPerBlock != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxSenderTxsPerBlock))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.BlockedCallees) > 0 {
+		for iNdEx := len(m.BlockedCallees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedCallees[iNdEx])
+			copy(dAtA[i:], m.BlockedCallees[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedCallees[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BlockedContractCreators) > 0 {
+		for iNdEx := len(m.BlockedContractCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedContractCreators[iNdEx])
+			copy(dAtA[i:], m.BlockedContractCreators[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedContractCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxInitCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxInitCodeSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCodeSize))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1378,7 +1850,7 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
+func (m *TxReceipt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1388,19 +1860,257 @@ func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccessTuple) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxReceipt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StorageKeys) > 0 {
-		for iNdEx := len(m.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.StorageKeys[iNdEx])
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CumulativeGasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.CumulativeGasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosTxInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosTxInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosTxInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CosmosTxHash) > 0 {
+		i -= len(m.CosmosTxHash)
+		copy(dAtA[i:], m.CosmosTxHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CosmosTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCreation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCreation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCreation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountDump) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDump) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageTruncated {
+		i--
+		if m.StorageTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageKeys) > 0 {
+		for iNdEx := len(m.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageKeys[iNdEx])
 			copy(dAtA[i:], m.StorageKeys[iNdEx])
 			i = encodeVarintEvm(dAtA, i, uint64(len(m.StorageKeys[iNdEx])))
 			i--
@@ -1572,6 +2282,30 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if m.MaxCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCodeSize))
+	}
+	if m.MaxInitCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxInitCodeSize))
+	}
+	if len(m.BlockedContractCreators) > 0 {
+		for _, s := range m.BlockedContractCreators {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.BlockedCallees) > 0 {
+		for _, s := range m.BlockedCallees {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.MaxSenderTxsPerBlock != 0 {
+		n += 1 + sovEvm(uint64(m.MaxSenderTxsPerBlock))
+	}
+	if m.MaxTxGasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.MaxTxGasLimit))
+	}
 	return n
 }
 
@@ -1767,49 +2501,163 @@ func (m *TxResult) Size() (n int) {
 	return n
 }
 
-func (m *AccessTuple) Size() (n int) {
+func (m *TxReceipt) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.TxHash)
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	if len(m.StorageKeys) > 0 {
-		for _, s := range m.StorageKeys {
-			l = len(s)
+	if m.BlockNumber != 0 {
+		n += 1 + sovEvm(uint64(m.BlockNumber))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovEvm(uint64(m.TxIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvm(uint64(m.Status))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvm(uint64(m.GasUsed))
+	}
+	if m.CumulativeGasUsed != 0 {
+		n += 1 + sovEvm(uint64(m.CumulativeGasUsed))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *TraceConfig) Size() (n int) {
+func (m *CosmosTxInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Tracer)
+	l = len(m.CosmosTxHash)
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	l = len(m.Timeout)
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvm(uint64(m.BlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovEvm(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *ContractCreation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	if m.Reexec != 0 {
-		n += 1 + sovEvm(uint64(m.Reexec))
-	}
-	if m.DisableStack {
-		n += 2
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
 	}
-	if m.DisableStorage {
-		n += 2
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvm(uint64(m.BlockHeight))
 	}
-	if m.Debug {
+	return n
+}
+
+func (m *AccountDump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvm(uint64(m.Nonce))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.StorageTruncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *AccessTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.StorageKeys) > 0 {
+		for _, s := range m.StorageKeys {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TraceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tracer)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Timeout)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Reexec != 0 {
+		n += 1 + sovEvm(uint64(m.Reexec))
+	}
+	if m.DisableStack {
+		n += 2
+	}
+	if m.DisableStorage {
+		n += 2
+	}
+	if m.Debug {
 		n += 2
 	}
 	if m.Limit != 0 {
@@ -2068,6 +2916,146 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+			}
+			m.MaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInitCodeSize", wireType)
+			}
+			m.MaxInitCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInitCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedContractCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedContractCreators = append(m.BlockedContractCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedCallees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedCallees = append(m.BlockedCallees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSenderTxsPerBlock", wireType)
+			}
+			m.MaxSenderTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSenderTxsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxGasLimit", wireType)
+			}
+			m.MaxTxGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2936,19 +3924,773 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bloom == nil {
+				m.Bloom = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGasUsed", wireType)
+			}
+			m.CumulativeGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CumulativeGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2976,9 +4718,9 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
@@ -3033,7 +4775,7 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Log) Unmarshal(dAtA []byte) error {
+func (m *CosmosTxInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3056,15 +4798,15 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+			return fmt.Errorf("proto: CosmosTxInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CosmosTxInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3092,13 +4834,13 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.CosmosTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3108,29 +4850,16 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			var byteLen int
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3140,48 +4869,64 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvm
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCreation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCreation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCreation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3209,30 +4954,11 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
-			}
-			m.TxIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3260,32 +4986,13 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var v int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3295,12 +5002,11 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Removed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3322,7 +5028,7 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxResult) Unmarshal(dAtA []byte) error {
+func (m *AccountDump) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3345,15 +5051,15 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountDump: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountDump: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3381,13 +5087,13 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3397,31 +5103,48 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bloom == nil {
-				m.Bloom = []byte{}
-			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3431,28 +5154,27 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3479,16 +5201,16 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3498,17 +5220,31 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reverted = bool(v != 0)
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageTruncated", wireType)
 			}
-			m.GasUsed = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3518,11 +5254,12 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.StorageTruncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -4061,4 +5798,4 @@ var (
 	ErrInvalidLengthEvm        = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowEvm          = fmt.Errorf("proto: integer overflow")
 	ErrUnexpectedEndOfGroupEvm = fmt.Errorf("proto: unexpected end of group")
-)
\ No newline at end of file
+)
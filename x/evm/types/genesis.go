@@ -16,7 +16,13 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 
 	ethermint "github.com/evmos/ethermint/types"
 )
@@ -26,7 +32,14 @@ func (ga GenesisAccount) Validate() error {
 	if err := ethermint.ValidateAddress(ga.Address); err != nil {
 		return err
 	}
-	return ga.Storage.Validate()
+	// an EOA has no storage
+	if ga.Code == "" && len(ga.Storage) > 0 {
+		return fmt.Errorf("account %s: account with empty code must not have storage", ga.Address)
+	}
+	if err := ga.Storage.Validate(); err != nil {
+		return fmt.Errorf("account %s: %w", ga.Address, err)
+	}
+	return nil
 }
 
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
@@ -49,16 +62,40 @@ func NewGenesisState(params Params, accounts []GenesisAccount) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// the native precompiles enabled by the chain config at genesis
+	ethCfg := gs.Params.ChainConfig.EthereumConfig(nil)
+	precompiles := vm.ActivePrecompiles(ethCfg.Rules(big.NewInt(0), ethCfg.MergeNetsplitBlock != nil))
+
 	seenAccounts := make(map[string]bool)
 	for _, acc := range gs.Accounts {
 		if seenAccounts[acc.Address] {
 			return fmt.Errorf("duplicated genesis account %s", acc.Address)
 		}
 		if err := acc.Validate(); err != nil {
-			return fmt.Errorf("invalid genesis account %s: %w", acc.Address, err)
+			return fmt.Errorf("invalid genesis account: %w", err)
+		}
+		if err := validatePrecompileAccount(acc, precompiles); err != nil {
+			return fmt.Errorf("invalid genesis account: %w", err)
 		}
 		seenAccounts[acc.Address] = true
 	}
 
 	return gs.Params.Validate()
 }
+
+// validatePrecompileAccount checks that a genesis account doesn't shadow a native precompile
+// (0x01-0x09) with contract code. The only code allowed at these addresses is the PrecompileCode
+// placeholder, and only for a precompile enabled at genesis.
+func validatePrecompileAccount(acc GenesisAccount, enabled []common.Address) error {
+	address := common.HexToAddress(acc.Address)
+	if acc.Code == "" || !slices.Contains(vm.PrecompiledAddressesBerlin, address) {
+		return nil
+	}
+	if !bytes.Equal(common.Hex2Bytes(acc.Code), PrecompileCode) {
+		return fmt.Errorf("account %s: contract code at a reserved precompile address", acc.Address)
+	}
+	if !slices.Contains(enabled, address) {
+		return fmt.Errorf("account %s: precompile not enabled at genesis", acc.Address)
+	}
+	return nil
+}
@@ -60,6 +60,14 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 		},
 		{
 			"empty code bytes",
+			GenesisAccount{
+				Address: suite.address,
+				Code:    "",
+			},
+			true,
+		},
+		{
+			"empty code bytes with storage",
 			GenesisAccount{
 				Address: suite.address,
 				Code:    "",
@@ -67,7 +75,7 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 					NewState(suite.hash, suite.hash),
 				},
 			},
-			true,
+			false,
 		},
 	}
 
@@ -82,6 +90,22 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestValidateGenesisAccountDuplicateState() {
+	genesisAccount := GenesisAccount{
+		Address: suite.address,
+		Code:    suite.code,
+		Storage: Storage{
+			NewState(suite.hash, suite.hash),
+			NewState(suite.hash, suite.hash),
+		},
+	}
+
+	err := genesisAccount.Validate()
+	suite.Require().ErrorIs(err, ErrInvalidState)
+	suite.Require().Contains(err.Error(), "account "+suite.address)
+	suite.Require().Contains(err.Error(), "duplicate state key")
+}
+
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	testCases := []struct {
 		name     string
@@ -223,3 +247,70 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) TestValidateGenesisPrecompileAccount() {
+	// blake2f (0x09) is enabled by Istanbul
+	preIstanbul := DefaultParams()
+	preIstanbul.ChainConfig.IstanbulBlock = nil
+	preIstanbul.ChainConfig.MuirGlacierBlock = nil
+	preIstanbul.ChainConfig.BerlinBlock = nil
+	preIstanbul.ChainConfig.LondonBlock = nil
+	preIstanbul.ChainConfig.ArrowGlacierBlock = nil
+	preIstanbul.ChainConfig.GrayGlacierBlock = nil
+	preIstanbul.ChainConfig.MergeNetsplitBlock = nil
+	preIstanbul.ChainConfig.ShanghaiBlock = nil
+	preIstanbul.ChainConfig.CancunBlock = nil
+
+	ecrecover := common.BytesToAddress([]byte{0x01}).String()
+	blake2f := common.BytesToAddress([]byte{0x09}).String()
+	sentinel := common.Bytes2Hex(PrecompileCode)
+
+	testCases := []struct {
+		name    string
+		account GenesisAccount
+		params  Params
+		expErr  string
+	}{
+		{
+			"contract code at a precompile address",
+			GenesisAccount{Address: ecrecover, Code: suite.code},
+			DefaultParams(),
+			"contract code at a reserved precompile address",
+		},
+		{
+			"precompile code at an enabled precompile address",
+			GenesisAccount{Address: ecrecover, Code: sentinel},
+			DefaultParams(),
+			"",
+		},
+		{
+			"precompile code at a precompile address not enabled",
+			GenesisAccount{Address: blake2f, Code: sentinel},
+			preIstanbul,
+			"precompile not enabled at genesis",
+		},
+		{
+			"account without code at a precompile address",
+			GenesisAccount{Address: ecrecover},
+			DefaultParams(),
+			"",
+		},
+		{
+			"precompile code outside the precompile addresses",
+			GenesisAccount{Address: suite.address, Code: sentinel},
+			preIstanbul,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := NewGenesisState(tc.params, []GenesisAccount{tc.account}).Validate()
+			if tc.expErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr)
+			}
+		})
+	}
+}
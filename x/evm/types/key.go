@@ -16,6 +16,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -41,6 +42,17 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixBlockLogs
+	prefixBlockHeight
+	prefixBlockBloom
+	prefixCodeSize
+	prefixTxReceipt
+	prefixCosmosTxInfo
+	prefixParamsHistory
+	prefixContractCreation
+	prefixBlockTxs
+	prefixContract
+	prefixTx
 )
 
 // prefix bytes for the EVM transient store
@@ -49,6 +61,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientDestructed
+	prefixTransientSenderTxCount
 )
 
 // KVStore key prefixes
@@ -56,6 +70,19 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixBlockLogs   = []byte{prefixBlockLogs}
+	KeyPrefixBlockHeight = []byte{prefixBlockHeight}
+	KeyPrefixBlockBloom  = []byte{prefixBlockBloom}
+
+	KeyPrefixCodeSize         = []byte{prefixCodeSize}
+	KeyPrefixTxReceipt        = []byte{prefixTxReceipt}
+	KeyPrefixCosmosTxInfo     = []byte{prefixCosmosTxInfo}
+	KeyPrefixParamsHistory    = []byte{prefixParamsHistory}
+	KeyPrefixContractCreation = []byte{prefixContractCreation}
+	KeyPrefixBlockTxs         = []byte{prefixBlockTxs}
+	KeyPrefixContract         = []byte{prefixContract}
+	KeyPrefixTx               = []byte{prefixTx}
 )
 
 // Transient Store key prefixes
@@ -64,6 +91,9 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+
+	KeyPrefixTransientDestructed    = []byte{prefixTransientDestructed}
+	KeyPrefixTransientSenderTxCount = []byte{prefixTransientSenderTxCount}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -75,3 +105,86 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// BlockLogsPrefix returns a prefix to iterate over the logs emitted on a given block height.
+func BlockLogsPrefix(height uint64) []byte {
+	return append(KeyPrefixBlockLogs, sdk.Uint64ToBigEndian(height)...)
+}
+
+// BlockHeightKey defines the key under which the height of a given block hash is stored.
+func BlockHeightKey(blockHash common.Hash) []byte {
+	return append(KeyPrefixBlockHeight, blockHash.Bytes()...)
+}
+
+// BlockBloomKey defines the key under which the bloom filter of a given block height is stored.
+func BlockBloomKey(height uint64) []byte {
+	return append(KeyPrefixBlockBloom, sdk.Uint64ToBigEndian(height)...)
+}
+
+// CodeSizeKey defines the key under which the size of the code with a given hash is stored.
+func CodeSizeKey(codeHash []byte) []byte {
+	return append(KeyPrefixCodeSize, codeHash...)
+}
+
+// TxReceiptKey defines the key under which the receipt of the transaction with the given hash is stored.
+func TxReceiptKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTxReceipt, txHash.Bytes()...)
+}
+
+// TxKey defines the key under which the encoded transaction with the given hash is stored.
+func TxKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTx, txHash.Bytes()...)
+}
+
+// CosmosTxInfoKey defines the key under which the cosmos tx wrapping the ethereum tx with the given
+// hash is recorded.
+func CosmosTxInfoKey(ethTxHash common.Hash) []byte {
+	return append(KeyPrefixCosmosTxInfo, ethTxHash.Bytes()...)
+}
+
+// ParamsHistoryKey defines the key under which the params in effect from a given block height are
+// stored.
+func ParamsHistoryKey(height uint64) []byte {
+	return append(KeyPrefixParamsHistory, sdk.Uint64ToBigEndian(height)...)
+}
+
+// ContractCreationKey defines the key under which the creation of the contract with the given
+// address is recorded.
+func ContractCreationKey(address common.Address) []byte {
+	return append(KeyPrefixContractCreation, address.Bytes()...)
+}
+
+// BlockTxsPrefix returns a prefix to iterate over the hashes of the ethereum txs executed on a given
+// block height.
+func BlockTxsPrefix(height uint64) []byte {
+	return append(KeyPrefixBlockTxs, sdk.Uint64ToBigEndian(height)...)
+}
+
+// BlockTxKey defines the key under which the hash of the ethereum tx with the given index in a
+// given block height is stored.
+func BlockTxKey(height, txIndex uint64) []byte {
+	return append(BlockTxsPrefix(height), sdk.Uint64ToBigEndian(txIndex)...)
+}
+
+// ContractKey defines the key under which the address of a contract account is indexed.
+func ContractKey(address common.Address) []byte {
+	return append(KeyPrefixContract, address.Bytes()...)
+}
+
+// TransientDestructedPrefix returns a prefix to iterate over the accounts self-destructed by the
+// transaction with the given index in the current block.
+func TransientDestructedPrefix(txIndex uint64) []byte {
+	return append(KeyPrefixTransientDestructed, sdk.Uint64ToBigEndian(txIndex)...)
+}
+
+// TransientDestructedKey defines the key under which an account self-destructed by the transaction
+// with the given index is recorded.
+func TransientDestructedKey(txIndex uint64, address common.Address) []byte {
+	return append(TransientDestructedPrefix(txIndex), address.Bytes()...)
+}
+
+// TransientSenderTxCountKey defines the key under which the number of transactions of the given
+// sender in the current block is recorded.
+func TransientSenderTxCountKey(sender common.Address) []byte {
+	return append(KeyPrefixTransientSenderTxCount, sender.Bytes()...)
+}
@@ -49,6 +49,7 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgResetParams{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -385,6 +386,24 @@ func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
 	return from, nil
 }
 
+// VerifyFrom recovers the sender from the transaction signature and checks that it matches the
+// From field of the message. Unlike GetSender, it doesn't update the From field.
+func (msg *MsgEthereumTx) VerifyFrom(signer ethtypes.Signer) error {
+	if msg.From == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "sender address not defined for message")
+	}
+
+	sender, err := signer.Sender(msg.AsTransaction())
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "couldn't retrieve sender address from the ethereum transaction: %s", err)
+	}
+
+	if !common.IsHexAddress(msg.From) || common.HexToAddress(msg.From) != sender {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "from address %s doesn't match the signer address %s", msg.From, sender.Hex())
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
 func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(msg.Data, new(TxData))
@@ -392,13 +411,27 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(b); err != nil {
+	tx, err := UnmarshalEthereumTx(b)
+	if err != nil {
 		return err
 	}
 	return msg.FromEthereumTx(tx)
 }
 
+// UnmarshalEthereumTx decodes the canonical encoding of an ethereum transaction. It returns
+// ErrUnsupportedTxType for the EIP-4844 blob transactions, which are not supported yet.
+func UnmarshalEthereumTx(b []byte) (*ethtypes.Transaction, error) {
+	if len(b) > 0 && b[0] == BlobTxType {
+		return nil, errorsmod.Wrap(ErrUnsupportedTxType, "EIP-4844 blob transactions are not supported")
+	}
+
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (authsigning.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
@@ -442,12 +475,26 @@ func GetSignersFromMsgEthereumTxV2(msg protov2.Message) ([][]byte, error) {
 		return nil, err
 	}
 
-	signers := [][]byte{}
-	for _, signer := range msgv1.GetSigners() {
-		signers = append(signers, signer.Bytes())
+	data, err := UnpackTxData(msgv1.Data)
+	if err != nil {
+		return nil, err
+	}
+	signer := ethtypes.LatestSignerForChainID(data.GetChainID())
+
+	// the sender is recovered from the signature, for both contract creations and calls, a From
+	// field set on the message must match it
+	if msgv1.From != "" {
+		if err := msgv1.VerifyFrom(signer); err != nil {
+			return nil, err
+		}
+		return [][]byte{common.HexToAddress(msgv1.From).Bytes()}, nil
 	}
 
-	return signers, nil
+	sender, err := signer.Sender(msgv1.AsTransaction())
+	if err != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "couldn't retrieve sender address from the ethereum transaction: %s", err)
+	}
+	return [][]byte{sender.Bytes()}, nil
 }
 
 func GetMsgEthereumTxFromMsgV2(msg protov2.Message) (MsgEthereumTx, error) {
@@ -455,6 +502,9 @@ func GetMsgEthereumTxFromMsgV2(msg protov2.Message) (MsgEthereumTx, error) {
 	if !ok {
 		return MsgEthereumTx{}, fmt.Errorf("invalid x/evm/MsgEthereumTx msg v2: %v", msg)
 	}
+	if msgv2.Data == nil {
+		return MsgEthereumTx{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, "tx data cannot be nil")
+	}
 
 	var dataAny *codectypes.Any
 	var err error
@@ -480,8 +530,10 @@ func GetMsgEthereumTxFromMsgV2(msg protov2.Message) (MsgEthereumTx, error) {
 		if err != nil {
 			return MsgEthereumTx{}, err
 		}
+	default:
+		return MsgEthereumTx{}, errorsmod.Wrapf(ErrUnsupportedTxType, "unknown tx data type %s", msgv2.Data.TypeUrl)
 	}
-	msgv1 := MsgEthereumTx{Data: dataAny}
+	msgv1 := MsgEthereumTx{Data: dataAny, From: msgv2.From}
 	msgv1.Hash = msgv1.AsTransaction().Hash().Hex()
 	return msgv1, nil
 }
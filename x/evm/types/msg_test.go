@@ -9,6 +9,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
+	protov2 "google.golang.org/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -20,6 +21,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	evmapi "github.com/evmos/ethermint/api/ethermint/evm/v1"
 	"github.com/evmos/ethermint/encoding"
 	"github.com/evmos/ethermint/x/evm"
 	"github.com/evmos/ethermint/x/evm/types"
@@ -522,6 +524,141 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_Sign() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumTx_VerifyFrom() {
+	ethSigner := ethtypes.LatestSignerForChainID(suite.chainID)
+
+	testCases := []struct {
+		msg        string
+		malleate   func(tx *types.MsgEthereumTx)
+		expectPass bool
+	}{
+		{
+			"pass - signed by from address",
+			func(tx *types.MsgEthereumTx) {
+				suite.Require().NoError(tx.Sign(ethSigner, suite.signer))
+			},
+			true,
+		},
+		{
+			"fail - from address tampered after signing",
+			func(tx *types.MsgEthereumTx) {
+				suite.Require().NoError(tx.Sign(ethSigner, suite.signer))
+				tx.From = suite.to.Hex()
+			},
+			false,
+		},
+		{
+			"fail - invalid from address",
+			func(tx *types.MsgEthereumTx) {
+				suite.Require().NoError(tx.Sign(ethSigner, suite.signer))
+				tx.From = invalidFromAddress
+			},
+			false,
+		},
+		{
+			"fail - unsigned",
+			func(tx *types.MsgEthereumTx) {},
+			false,
+		},
+		{
+			"fail - no from address",
+			func(tx *types.MsgEthereumTx) {
+				suite.Require().NoError(tx.Sign(ethSigner, suite.signer))
+				tx.From = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tx := types.NewTx(suite.chainID, 0, &suite.to, nil, 100000, nil, big.NewInt(1), big.NewInt(1), []byte("test"), &ethtypes.AccessList{})
+			tx.From = suite.from.Hex()
+			tc.malleate(tx)
+
+			err := tx.VerifyFrom(ethSigner)
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestGetSignersFromMsgEthereumTxV2() {
+	ethSigner := ethtypes.LatestSignerForChainID(suite.chainID)
+
+	testCases := []struct {
+		msg        string
+		to         *common.Address
+		malleate   func(tx *types.MsgEthereumTx)
+		expectPass bool
+	}{
+		{
+			"pass - call",
+			&suite.to,
+			func(tx *types.MsgEthereumTx) {},
+			true,
+		},
+		{
+			"pass - contract creation",
+			nil,
+			func(tx *types.MsgEthereumTx) {},
+			true,
+		},
+		{
+			"pass - contract creation without from address",
+			nil,
+			func(tx *types.MsgEthereumTx) { tx.From = "" },
+			true,
+		},
+		{
+			"fail - from address not matching the signer",
+			nil,
+			func(tx *types.MsgEthereumTx) { tx.From = suite.to.Hex() },
+			false,
+		},
+		{
+			"fail - invalid from address",
+			&suite.to,
+			func(tx *types.MsgEthereumTx) { tx.From = invalidFromAddress },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tx := types.NewTx(suite.chainID, 0, tc.to, nil, 100000, nil, big.NewInt(1), big.NewInt(1), []byte("test"), &ethtypes.AccessList{})
+			tx.From = suite.from.Hex()
+			suite.Require().NoError(tx.Sign(ethSigner, suite.signer))
+			tc.malleate(tx)
+
+			bz, err := tx.Marshal()
+			suite.Require().NoError(err)
+			msgv2 := &evmapi.MsgEthereumTx{}
+			suite.Require().NoError(protov2.Unmarshal(bz, msgv2))
+
+			signers, err := types.GetSignersFromMsgEthereumTxV2(msgv2)
+			if tc.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([][]byte{suite.from.Bytes()}, signers)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	// unsigned
+	tx := types.NewTx(suite.chainID, 0, nil, nil, 100000, nil, big.NewInt(1), big.NewInt(1), []byte("test"), &ethtypes.AccessList{})
+	bz, err := tx.Marshal()
+	suite.Require().NoError(err)
+	msgv2 := &evmapi.MsgEthereumTx{}
+	suite.Require().NoError(protov2.Unmarshal(bz, msgv2))
+	_, err = types.GetSignersFromMsgEthereumTxV2(msgv2)
+	suite.Require().Error(err)
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_Getters() {
 	testCases := []struct {
 		name      string
@@ -769,6 +906,18 @@ func (suite *MsgsTestSuite) TestTransactionCoding() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestUnmarshalBinaryBlobTx() {
+	// a type-3 typed transaction envelope, the payload is irrelevant as it's rejected by type
+	data := append([]byte{types.BlobTxType}, []byte{0xc0}...)
+
+	tx := &types.MsgEthereumTx{}
+	err := tx.UnmarshalBinary(data)
+	suite.Require().ErrorIs(err, types.ErrUnsupportedTxType)
+
+	_, err = types.UnmarshalEthereumTx(data)
+	suite.Require().ErrorIs(err, types.ErrUnsupportedTxType)
+}
+
 func encodeDecodeBinary(tx *ethtypes.Transaction) (*types.MsgEthereumTx, error) {
 	data, err := tx.MarshalBinary()
 	if err != nil {
@@ -16,9 +16,13 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	"slices"
 
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,8 +39,17 @@ var (
 	DefaultEnableCreate = true
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+	// DefaultMaxCodeSize is the EIP-170 contract code size limit (i.e 24576 bytes)
+	DefaultMaxCodeSize = uint64(params.MaxCodeSize)
+	// DefaultMaxInitCodeSize is the EIP-3860 init code size limit (i.e 49152 bytes)
+	DefaultMaxInitCodeSize = 2 * DefaultMaxCodeSize
 )
 
+// HistoryRetentionBlocks is the number of blocks whose ethereum tx logs, receipts, cosmos tx info and
+// bloom are kept in the store, the older ones are pruned on EndBlock so the store doesn't grow with
+// every block. The value is part of the state machine.
+const HistoryRetentionBlocks = 100_000
+
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
 // EVM interpreter. These EIPs are applied in order and can override the
 // instruction sets from the latest hard fork enabled by the ChainConfig. For
@@ -52,6 +65,8 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 		EnableCall:          enableCall,
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
+		MaxCodeSize:         DefaultMaxCodeSize,
+		MaxInitCodeSize:     DefaultMaxInitCodeSize,
 	}
 }
 
@@ -65,7 +80,35 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		MaxCodeSize:         DefaultMaxCodeSize,
+		MaxInitCodeSize:     DefaultMaxInitCodeSize,
+	}
+}
+
+// Diff returns the names of the parameters differing from the other ones.
+func (p Params) Diff(other Params) []string {
+	var diff []string
+	add := func(name string, equal bool) {
+		if !equal {
+			diff = append(diff, name)
+		}
 	}
+
+	add("evm_denom", p.EvmDenom == other.EvmDenom)
+	add("enable_create", p.EnableCreate == other.EnableCreate)
+	add("enable_call", p.EnableCall == other.EnableCall)
+	add("extra_eips", slices.Equal(p.ExtraEIPs, other.ExtraEIPs))
+	chainConfig, _ := p.ChainConfig.Marshal()
+	otherChainConfig, _ := other.ChainConfig.Marshal()
+	add("chain_config", bytes.Equal(chainConfig, otherChainConfig))
+	add("allow_unprotected_txs", p.AllowUnprotectedTxs == other.AllowUnprotectedTxs)
+	add("max_code_size", p.MaxCodeSize == other.MaxCodeSize)
+	add("max_init_code_size", p.MaxInitCodeSize == other.MaxInitCodeSize)
+	add("blocked_contract_creators", slices.Equal(p.BlockedContractCreators, other.BlockedContractCreators))
+	add("blocked_callees", slices.Equal(p.BlockedCallees, other.BlockedCallees))
+	add("max_sender_txs_per_block", p.MaxSenderTxsPerBlock == other.MaxSenderTxsPerBlock)
+	add("max_tx_gas_limit", p.MaxTxGasLimit == other.MaxTxGasLimit)
+	return diff
 }
 
 // Validate performs basic validation on evm parameters.
@@ -90,9 +133,79 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxCodeSize(p.MaxCodeSize); err != nil {
+		return err
+	}
+
+	if err := validateMaxInitCodeSize(p.MaxInitCodeSize); err != nil {
+		return err
+	}
+
+	if err := validateAddresses(p.BlockedContractCreators); err != nil {
+		return fmt.Errorf("invalid blocked contract creators: %w", err)
+	}
+
+	if err := validateAddresses(p.BlockedCallees); err != nil {
+		return fmt.Errorf("invalid blocked callees: %w", err)
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
+// IsBlockedContractCreator returns true if the address isn't allowed to send contract creation
+// transactions. The contracts created by a factory contract aren't filtered.
+func (p Params) IsBlockedContractCreator(addr common.Address) bool {
+	return containsAddress(p.BlockedContractCreators, addr)
+}
+
+// IsBlockedCallee returns true if the address can't be the recipient of a transaction. The calls made
+// by contracts aren't filtered.
+func (p Params) IsBlockedCallee(addr common.Address) bool {
+	return containsAddress(p.BlockedCallees, addr)
+}
+
+// CheckTxGasLimit returns an error if the gas limit declared by a transaction exceeds the
+// MaxTxGasLimit param, a zero param disables the check.
+func (p Params) CheckTxGasLimit(gasLimit uint64) error {
+	if p.MaxTxGasLimit > 0 && gasLimit > p.MaxTxGasLimit {
+		return errorsmod.Wrapf(ErrTxGasLimitExceeded, "gas limit %d, max %d", gasLimit, p.MaxTxGasLimit)
+	}
+	return nil
+}
+
+func containsAddress(addresses []string, addr common.Address) bool {
+	for _, address := range addresses {
+		if common.HexToAddress(address) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ForkEIPs returns the extra EIPs that are already included in a hard fork of the chain config active
+// at the given height. Enabling them again re-applies the same instruction set changes, e.g the
+// EIP-2929 gas rules once Berlin is active.
+func (p Params) ForkEIPs(height *big.Int) []int64 {
+	cfg := p.ChainConfig.EthereumConfig(nil)
+
+	var eips []int64
+	for _, eip := range p.ExtraEIPs {
+		var included bool
+		switch eip {
+		case 1344, 1884, 2200:
+			included = cfg.IsIstanbul(height)
+		case 2929:
+			included = cfg.IsBerlin(height)
+		case 3198, 3529:
+			included = cfg.IsLondon(height)
+		}
+		if included {
+			eips = append(eips, eip)
+		}
+	}
+	return eips
+}
+
 // EIPs returns the ExtraEIPS as a int slice
 func (p Params) EIPs() []int {
 	eips := make([]int, len(p.ExtraEIPs))
@@ -134,6 +247,58 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validateMaxCodeSize(i interface{}) error {
+	size, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter max code size type: %T", i)
+	}
+
+	if size == 0 {
+		return fmt.Errorf("max code size must be positive")
+	}
+
+	// the EVM enforces the EIP-170 limit on its own, so it can't be raised
+	if size > params.MaxCodeSize {
+		return fmt.Errorf("max code size %d exceeds the EIP-170 limit %d", size, params.MaxCodeSize)
+	}
+
+	return nil
+}
+
+func validateMaxInitCodeSize(i interface{}) error {
+	size, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter max init code size type: %T", i)
+	}
+
+	if size == 0 {
+		return fmt.Errorf("max init code size must be positive")
+	}
+
+	return nil
+}
+
+func validateAddresses(i interface{}) error {
+	addresses, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid address slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, address := range addresses {
+		if err := types.ValidateAddress(address); err != nil {
+			return err
+		}
+		addr := common.HexToAddress(address)
+		if seen[addr] {
+			return fmt.Errorf("duplicate address %s", address)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
@@ -40,6 +42,79 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"zero max code size",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+			},
+			true,
+		},
+		{
+			"max code size above EIP-170",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				MaxCodeSize: params.MaxCodeSize + 1,
+			},
+			true,
+		},
+		{
+			"lower max code size",
+			Params{
+				EvmDenom:        "stake",
+				ChainConfig:     DefaultChainConfig(),
+				MaxCodeSize:     1024,
+				MaxInitCodeSize: DefaultMaxInitCodeSize,
+			},
+			false,
+		},
+		{
+			"zero max init code size",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				MaxCodeSize: DefaultMaxCodeSize,
+			},
+			true,
+		},
+		{
+			"valid blocked addresses",
+			Params{
+				EvmDenom:                "stake",
+				ChainConfig:             DefaultChainConfig(),
+				MaxCodeSize:             DefaultMaxCodeSize,
+				MaxInitCodeSize:         DefaultMaxInitCodeSize,
+				BlockedContractCreators: []string{"0x1000000000000000000000000000000000000001"},
+				BlockedCallees:          []string{"0x1000000000000000000000000000000000000002"},
+			},
+			false,
+		},
+		{
+			"invalid blocked contract creator",
+			Params{
+				EvmDenom:                "stake",
+				ChainConfig:             DefaultChainConfig(),
+				MaxCodeSize:             DefaultMaxCodeSize,
+				MaxInitCodeSize:         DefaultMaxInitCodeSize,
+				BlockedContractCreators: []string{"0x1000"},
+			},
+			true,
+		},
+		{
+			"duplicate blocked callee",
+			Params{
+				EvmDenom:        "stake",
+				ChainConfig:     DefaultChainConfig(),
+				MaxCodeSize:     DefaultMaxCodeSize,
+				MaxInitCodeSize: DefaultMaxInitCodeSize,
+				BlockedCallees: []string{
+					"0x1000000000000000000000000000000000000002",
+					"0x1000000000000000000000000000000000000002",
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -61,6 +136,25 @@ func TestParamsEIPs(t *testing.T) {
 	require.Equal(t, []int([]int{2929, 1884, 1344}), actual)
 }
 
+func TestParamsForkEIPs(t *testing.T) {
+	params := NewParams("ara", false, true, true, DefaultChainConfig(), []int64{2929, 3198})
+	require.Equal(t, []int64{2929, 3198}, params.ForkEIPs(big.NewInt(1)))
+
+	// Berlin active, London not yet
+	london := sdkmath.NewInt(10)
+	params.ChainConfig.LondonBlock = &london
+	params.ChainConfig.ArrowGlacierBlock = &london
+	params.ChainConfig.GrayGlacierBlock = &london
+	params.ChainConfig.MergeNetsplitBlock = &london
+	params.ChainConfig.ShanghaiBlock = &london
+	params.ChainConfig.CancunBlock = &london
+	require.Equal(t, []int64{2929}, params.ForkEIPs(big.NewInt(1)))
+	require.Equal(t, []int64{2929, 3198}, params.ForkEIPs(big.NewInt(10)))
+
+	params.ExtraEIPs = nil
+	require.Empty(t, params.ForkEIPs(big.NewInt(10)))
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateEVMDenom(false))
 	require.NoError(t, validateEVMDenom("inj"))
@@ -0,0 +1,48 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AccountProof is the merkle proof of an account and of some of its storage slots at a committed
+// height. The proofs are ICS-23 proofs against the cosmos IAVL trees of the auth (account) and evm
+// (storage) stores, chained to the app hash of the height. They aren't Ethereum MPT proofs, so they
+// can't be verified against an Ethereum state root.
+type AccountProof struct {
+	// Address is the address of the proven account
+	Address common.Address
+	// Height is the committed height the proofs are made at
+	Height int64
+	// Account is the encoded auth account, empty if the account doesn't exist
+	Account []byte
+	// Proof proves the account, or its absence, under the auth store
+	Proof *cmtcrypto.ProofOps
+	// StorageProofs are the proofs of the requested storage slots, in the requested order
+	StorageProofs []StorageProof
+}
+
+// StorageProof is the merkle proof of a storage slot under the evm store.
+type StorageProof struct {
+	// Key is the storage slot
+	Key common.Hash
+	// Value is the value of the slot, empty if the slot isn't set
+	Value []byte
+	// Proof proves the slot value, or its absence, under the evm store
+	Proof *cmtcrypto.ProofOps
+}
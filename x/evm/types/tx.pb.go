@@ -441,6 +441,110 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgResetParams defines a Msg for resetting the x/evm module parameters to
+// their default values.
+type MsgResetParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// reset_evm_denom resets the evm denom as well, it's kept by default.
+	ResetEvmDenom bool `protobuf:"varint,2,opt,name=reset_evm_denom,json=resetEvmDenom,proto3" json:"reset_evm_denom,omitempty"`
+	// reset_chain_config resets the chain config fork schedule as well, it's kept
+	// by default.
+	ResetChainConfig bool `protobuf:"varint,3,opt,name=reset_chain_config,json=resetChainConfig,proto3" json:"reset_chain_config,omitempty"`
+}
+
+func (m *MsgResetParams) Reset()         { *m = MsgResetParams{} }
+func (m *MsgResetParams) String() string { return proto.CompactTextString(m) }
+func (*MsgResetParams) ProtoMessage()    {}
+func (*MsgResetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgResetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetParams.Merge(m, src)
+}
+func (m *MsgResetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetParams proto.InternalMessageInfo
+
+func (m *MsgResetParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResetParams) GetResetEvmDenom() bool {
+	if m != nil {
+		return m.ResetEvmDenom
+	}
+	return false
+}
+
+func (m *MsgResetParams) GetResetChainConfig() bool {
+	if m != nil {
+		return m.ResetChainConfig
+	}
+	return false
+}
+
+// MsgResetParamsResponse defines the response structure for executing a
+// MsgResetParams message.
+type MsgResetParamsResponse struct {
+}
+
+func (m *MsgResetParamsResponse) Reset()         { *m = MsgResetParamsResponse{} }
+func (m *MsgResetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetParamsResponse) ProtoMessage()    {}
+func (*MsgResetParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgResetParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetParamsResponse.Merge(m, src)
+}
+func (m *MsgResetParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -450,77 +554,85 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResetParams)(nil), "ethermint.evm.v1.MsgResetParams")
+	proto.RegisterType((*MsgResetParamsResponse)(nil), "ethermint.evm.v1.MsgResetParamsResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 1037 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x8b, 0x23, 0x45,
-	0x18, 0x9e, 0x4e, 0x3a, 0x5f, 0x95, 0xa8, 0x6b, 0x33, 0xc3, 0x74, 0xa2, 0x9b, 0x8e, 0x2d, 0xba,
-	0xb3, 0x03, 0xd3, 0xcd, 0x44, 0x10, 0x36, 0x5e, 0x9c, 0xec, 0xcc, 0x2e, 0x23, 0x33, 0xb8, 0xb4,
-	0xd9, 0x8b, 0x0a, 0xa1, 0xa6, 0x53, 0xd3, 0x29, 0x4c, 0x77, 0x35, 0x5d, 0x95, 0x90, 0x08, 0x82,
-	0xec, 0x49, 0x04, 0x41, 0xf0, 0x0f, 0x78, 0xf0, 0x20, 0x9e, 0xe6, 0x30, 0x27, 0x0f, 0x9e, 0x07,
-	0x2f, 0x2e, 0xeb, 0x45, 0x3c, 0x44, 0xc9, 0x08, 0x83, 0x73, 0xf4, 0x17, 0x48, 0x55, 0x75, 0x26,
-	0x5f, 0xce, 0x07, 0x82, 0x5e, 0x42, 0xbf, 0xf5, 0x7e, 0xd6, 0xf3, 0x3c, 0xbc, 0x15, 0x50, 0x44,
-	0xac, 0x8d, 0x22, 0x1f, 0x07, 0xcc, 0x46, 0x3d, 0xdf, 0xee, 0x6d, 0xda, 0xac, 0x6f, 0x85, 0x11,
-	0x61, 0x44, 0xbb, 0x75, 0xe1, 0xb2, 0x50, 0xcf, 0xb7, 0x7a, 0x9b, 0xa5, 0x55, 0x97, 0x50, 0x9f,
-	0x50, 0xdb, 0xa7, 0x1e, 0x8f, 0xf4, 0xa9, 0x27, 0x43, 0x4b, 0x45, 0xe9, 0x68, 0x0a, 0xcb, 0x96,
-	0x46, 0xec, 0x2a, 0x2d, 0x34, 0xe0, 0xc5, 0xa4, 0x6f, 0xd9, 0x23, 0x1e, 0x91, 0x39, 0xfc, 0x2b,
-	0x3e, 0x7d, 0xd9, 0x23, 0xc4, 0xeb, 0x20, 0x1b, 0x86, 0xd8, 0x86, 0x41, 0x40, 0x18, 0x64, 0x98,
-	0x04, 0xe3, 0x7a, 0xc5, 0xd8, 0x2b, 0xac, 0x83, 0xee, 0xa1, 0x0d, 0x83, 0x41, 0xec, 0x7a, 0x11,
-	0xfa, 0x38, 0x20, 0xb6, 0xf8, 0x95, 0x47, 0xe6, 0x89, 0x02, 0x9e, 0xdb, 0xa7, 0xde, 0x0e, 0x9f,
-	0x01, 0x75, 0xfd, 0x46, 0x5f, 0xdb, 0x01, 0x6a, 0x0b, 0x32, 0xa8, 0x2b, 0x15, 0x65, 0x2d, 0x5f,
-	0x5d, 0xb6, 0x64, 0x39, 0x6b, 0x5c, 0xce, 0xda, 0x0a, 0x06, 0xf5, 0x97, 0x7e, 0x3c, 0xde, 0x58,
-	0x9d, 0xbf, 0xbd, 0xd5, 0xe8, 0x6f, 0x43, 0x06, 0x1d, 0x91, 0xae, 0x15, 0x81, 0x4a, 0xf1, 0xc7,
-	0x48, 0x4f, 0x54, 0x94, 0x35, 0xa5, 0x9e, 0x3a, 0x1f, 0x1a, 0xca, 0x86, 0x23, 0x8e, 0x34, 0x03,
-	0xa8, 0x6d, 0x48, 0xdb, 0x7a, 0xb2, 0xa2, 0xac, 0xe5, 0xea, 0xf9, 0xbf, 0x86, 0x46, 0x26, 0xea,
-	0x84, 0x35, 0x73, 0xc3, 0x74, 0x84, 0x43, 0xd3, 0x80, 0x7a, 0x18, 0x11, 0x5f, 0x57, 0x79, 0x80,
-	0x23, 0xbe, 0x6b, 0x95, 0xcf, 0xbe, 0x36, 0x96, 0x3e, 0x3f, 0x3b, 0x5a, 0x9f, 0xf4, 0xb5, 0x67,
-	0x06, 0x37, 0x7f, 0x48, 0x80, 0xec, 0x1e, 0xf2, 0xa0, 0x3b, 0x68, 0xf4, 0xb5, 0x65, 0x90, 0x0a,
-	0x48, 0xe0, 0x22, 0x71, 0x0d, 0xd5, 0x91, 0x86, 0xb6, 0x0d, 0x72, 0x1e, 0xe4, 0x2c, 0x60, 0x57,
-	0x4e, 0x96, 0xab, 0xdf, 0xf9, 0x75, 0x68, 0xac, 0x48, 0x42, 0x68, 0xeb, 0x23, 0x0b, 0x13, 0xdb,
-	0x87, 0xac, 0x6d, 0xed, 0x06, 0xec, 0xd9, 0xf1, 0x06, 0x88, 0x99, 0xda, 0x0d, 0x98, 0x93, 0xf5,
-	0x20, 0x7d, 0xc4, 0x13, 0xb5, 0x32, 0x48, 0x7a, 0x90, 0x8a, 0xf1, 0xd5, 0x7a, 0x61, 0x34, 0x34,
-	0xb2, 0x0f, 0x21, 0xdd, 0xc3, 0x3e, 0x66, 0x0e, 0x77, 0x68, 0xcf, 0x83, 0x04, 0x23, 0xf1, 0xf0,
-	0x09, 0x46, 0xb4, 0x87, 0x20, 0xd5, 0x83, 0x9d, 0x2e, 0xd2, 0x53, 0xa2, 0xe3, 0xe6, 0xa5, 0x1d,
-	0x47, 0x43, 0x23, 0xbd, 0xe5, 0x93, 0xee, 0x42, 0x6f, 0x99, 0xcf, 0x71, 0x11, 0xd4, 0xa4, 0x2b,
-	0xca, 0x5a, 0x21, 0xc6, 0xb9, 0x00, 0x94, 0x9e, 0x9e, 0x11, 0x07, 0x4a, 0x8f, 0x5b, 0x91, 0x9e,
-	0x95, 0x56, 0xc4, 0x2d, 0xaa, 0xe7, 0xa4, 0x45, 0x6b, 0x06, 0x47, 0xf0, 0x0a, 0xe2, 0xcc, 0x9f,
-	0x92, 0xa0, 0xb0, 0xe5, 0xba, 0x88, 0xd2, 0x3d, 0x4c, 0x59, 0xa3, 0xaf, 0xbd, 0x03, 0xb2, 0x6e,
-	0x1b, 0xe2, 0xa0, 0x89, 0x5b, 0x02, 0xc7, 0x5c, 0xdd, 0xbe, 0x6a, 0xf6, 0xcc, 0x7d, 0x1e, 0xbc,
-	0xbb, 0x7d, 0x3e, 0x34, 0x32, 0xae, 0xfc, 0x74, 0xe2, 0x8f, 0xd6, 0x84, 0x90, 0xc4, 0x34, 0x21,
-	0x6f, 0x4e, 0x13, 0x22, 0xf5, 0x50, 0xbc, 0xb4, 0xc5, 0x22, 0x05, 0xea, 0xd5, 0x14, 0xa4, 0x2e,
-	0x28, 0xb8, 0x37, 0xa6, 0x20, 0x2d, 0x7a, 0xbc, 0x7a, 0x03, 0x0a, 0xe6, 0x41, 0xcf, 0x4c, 0x81,
-	0xfe, 0x01, 0xc8, 0x42, 0x01, 0x14, 0xa2, 0x7a, 0xb6, 0x92, 0x5c, 0xcb, 0x57, 0x6f, 0x5b, 0x0b,
-	0xa8, 0x4a, 0x28, 0x1b, 0xdd, 0xb0, 0x83, 0xea, 0x95, 0x93, 0xa1, 0xb1, 0x74, 0x3e, 0x34, 0x00,
-	0xbc, 0xc0, 0xf7, 0xbb, 0xdf, 0x0c, 0x30, 0x41, 0xdb, 0xb9, 0x28, 0x28, 0x19, 0xcd, 0xcd, 0x30,
-	0x0a, 0x66, 0x18, 0xcd, 0xdf, 0x98, 0xd1, 0x2f, 0x54, 0x50, 0xd8, 0x1e, 0x04, 0xd0, 0xc7, 0xee,
-	0x03, 0x84, 0xfe, 0x17, 0x46, 0xef, 0x81, 0x3c, 0x67, 0x94, 0xe1, 0xb0, 0xe9, 0xc2, 0xf0, 0x7a,
-	0x4e, 0x39, 0xff, 0x0d, 0x1c, 0xde, 0x87, 0xe1, 0x38, 0xf5, 0x10, 0x21, 0x91, 0xaa, 0xde, 0x24,
-	0xf5, 0x01, 0x42, 0x3c, 0x35, 0xd6, 0x43, 0xea, 0x6a, 0x3d, 0xa4, 0x17, 0xf5, 0x90, 0xf9, 0xd7,
-	0x7a, 0xc8, 0x5e, 0xa2, 0x87, 0xdc, 0x7f, 0xa2, 0x07, 0x30, 0xa3, 0x87, 0xfc, 0x8c, 0x1e, 0x0a,
-	0x37, 0xd6, 0x83, 0x09, 0x4a, 0x3b, 0x7d, 0x86, 0x02, 0x8a, 0x49, 0xf0, 0x6e, 0x28, 0x5e, 0x8d,
-	0xc9, 0x02, 0xad, 0xa9, 0x3c, 0xdd, 0xfc, 0x46, 0x01, 0x2b, 0x33, 0x8b, 0xd5, 0x41, 0x34, 0x24,
-	0x01, 0x15, 0x37, 0x17, 0x7b, 0x5b, 0x91, 0x6b, 0x59, 0xac, 0xea, 0xbb, 0x40, 0xed, 0x10, 0x8f,
-	0xea, 0x09, 0x71, 0xeb, 0x95, 0xc5, 0x5b, 0xef, 0x11, 0xcf, 0x11, 0x21, 0xda, 0x2d, 0x90, 0x8c,
-	0x10, 0x13, 0x8a, 0x28, 0x38, 0xfc, 0x53, 0x2b, 0x82, 0x6c, 0xcf, 0x6f, 0xa2, 0x28, 0x22, 0x51,
-	0xbc, 0x2e, 0x33, 0x3d, 0x7f, 0x87, 0x9b, 0xdc, 0xc5, 0xb5, 0xd0, 0xa5, 0xa8, 0x25, 0x59, 0x75,
-	0x32, 0x1e, 0xa4, 0x8f, 0x29, 0x6a, 0xc5, 0x63, 0x7e, 0xaf, 0x80, 0x17, 0xf6, 0xa9, 0xf7, 0x38,
-	0x6c, 0x41, 0x86, 0x1e, 0xc1, 0x08, 0xfa, 0x94, 0x6f, 0x13, 0xd8, 0x65, 0x6d, 0x12, 0x61, 0x36,
-	0x88, 0xe5, 0xad, 0x3f, 0x3b, 0xde, 0x58, 0x8e, 0x37, 0xe9, 0x56, 0xab, 0x15, 0x21, 0x4a, 0xdf,
-	0x63, 0x11, 0x0e, 0x3c, 0x67, 0x12, 0xaa, 0xbd, 0x05, 0xd2, 0xa1, 0xa8, 0x20, 0xa4, 0x9c, 0xaf,
-	0xea, 0x8b, 0xd7, 0x90, 0x1d, 0xea, 0x39, 0xce, 0xdb, 0xb7, 0x67, 0x47, 0xeb, 0x8a, 0x13, 0xa7,
-	0xd4, 0xaa, 0x4f, 0xce, 0x8e, 0xd6, 0x27, 0xc5, 0xf8, 0x13, 0x65, 0x4c, 0x9e, 0xa8, 0xbe, 0x78,
-	0xd4, 0xe7, 0x06, 0x35, 0x8b, 0x60, 0x75, 0xee, 0x68, 0x0c, 0x72, 0xf5, 0x4f, 0x05, 0x24, 0xf7,
-	0xa9, 0xa7, 0x7d, 0x02, 0xc0, 0xd4, 0xa3, 0x6c, 0x2c, 0x4e, 0x34, 0xc3, 0x51, 0xe9, 0xce, 0x35,
-	0x01, 0xe3, 0xfa, 0xe6, 0x6b, 0x4f, 0x7e, 0xfe, 0xe3, 0xab, 0x84, 0x61, 0xde, 0xb6, 0x17, 0xff,
-	0x77, 0xc4, 0xd1, 0x4d, 0xd6, 0xd7, 0x3e, 0x04, 0x85, 0x19, 0x68, 0x5f, 0xf9, 0xc7, 0xfa, 0xd3,
-	0x21, 0xa5, 0xbb, 0xd7, 0x86, 0x8c, 0x87, 0x28, 0xa5, 0x3e, 0xe5, 0x10, 0xd6, 0xdf, 0x3e, 0x19,
-	0x95, 0x95, 0xa7, 0xa3, 0xb2, 0xf2, 0xfb, 0xa8, 0xac, 0x7c, 0x79, 0x5a, 0x5e, 0x7a, 0x7a, 0x5a,
-	0x5e, 0xfa, 0xe5, 0xb4, 0xbc, 0xf4, 0xfe, 0xeb, 0x1e, 0x66, 0xed, 0xee, 0x81, 0xe5, 0x12, 0x9f,
-	0x4f, 0x47, 0xa8, 0x3d, 0x0f, 0x29, 0x1b, 0x84, 0x88, 0x1e, 0xa4, 0xc5, 0xdf, 0x92, 0x37, 0xfe,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x96, 0x33, 0x9b, 0xa6, 0x09, 0x00, 0x00,
+	// 1132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcb, 0x6f, 0xe3, 0x44,
+	0x18, 0xaf, 0x13, 0xe7, 0x35, 0xc9, 0xee, 0x16, 0xab, 0xa5, 0x4e, 0x60, 0xe3, 0x60, 0xc4, 0x6e,
+	0xb6, 0xa2, 0xb6, 0x5a, 0x24, 0xa4, 0x0d, 0x17, 0x9a, 0xb6, 0xbb, 0x2a, 0x6a, 0xc5, 0xca, 0x64,
+	0x0f, 0x3c, 0xa4, 0x68, 0xea, 0x4c, 0x1d, 0x8b, 0xda, 0x63, 0x79, 0x26, 0x56, 0x82, 0x84, 0x84,
+	0xf6, 0x84, 0x90, 0x90, 0x90, 0xf8, 0x07, 0x38, 0x70, 0x40, 0x9c, 0x7a, 0xe8, 0x89, 0x03, 0x27,
+	0x0e, 0x15, 0x17, 0x96, 0xe5, 0x82, 0x38, 0x04, 0xd4, 0x22, 0x55, 0xea, 0x91, 0xbf, 0x00, 0xcd,
+	0x8c, 0xf3, 0x6a, 0xfa, 0x12, 0x08, 0x2e, 0xd1, 0x7c, 0xcf, 0xf9, 0xbe, 0xef, 0xf7, 0xcb, 0x37,
+	0x06, 0x45, 0x44, 0xdb, 0x28, 0xf4, 0x5c, 0x9f, 0x9a, 0x28, 0xf2, 0xcc, 0x68, 0xd9, 0xa4, 0x5d,
+	0x23, 0x08, 0x31, 0xc5, 0xca, 0xec, 0xd0, 0x64, 0xa0, 0xc8, 0x33, 0xa2, 0xe5, 0xd2, 0x82, 0x8d,
+	0x89, 0x87, 0x89, 0xe9, 0x11, 0x87, 0x79, 0x7a, 0xc4, 0x11, 0xae, 0xa5, 0xa2, 0x30, 0x34, 0xb9,
+	0x64, 0x0a, 0x21, 0x36, 0x95, 0xa6, 0x2e, 0x60, 0xc9, 0x84, 0x6d, 0xce, 0xc1, 0x0e, 0x16, 0x31,
+	0xec, 0x14, 0x6b, 0x5f, 0x74, 0x30, 0x76, 0xf6, 0x90, 0x09, 0x03, 0xd7, 0x84, 0xbe, 0x8f, 0x29,
+	0xa4, 0x2e, 0xf6, 0x07, 0xf9, 0x8a, 0xb1, 0x95, 0x4b, 0x3b, 0x9d, 0x5d, 0x13, 0xfa, 0xbd, 0xd8,
+	0xf4, 0x1c, 0xf4, 0x5c, 0x1f, 0x9b, 0xfc, 0x57, 0xa8, 0xf4, 0x43, 0x09, 0xdc, 0xd8, 0x26, 0xce,
+	0x06, 0xab, 0x01, 0x75, 0xbc, 0x46, 0x57, 0xd9, 0x00, 0x72, 0x0b, 0x52, 0xa8, 0x4a, 0x15, 0xa9,
+	0x9a, 0x5f, 0x99, 0x33, 0x44, 0x3a, 0x63, 0x90, 0xce, 0x58, 0xf5, 0x7b, 0xf5, 0x17, 0x7e, 0x3c,
+	0x58, 0x5a, 0x38, 0xdb, 0xbd, 0xd1, 0xe8, 0xae, 0x43, 0x0a, 0x2d, 0x1e, 0xae, 0x14, 0x81, 0x4c,
+	0xdc, 0x8f, 0x90, 0x9a, 0xa8, 0x48, 0x55, 0xa9, 0x9e, 0x3a, 0xed, 0x6b, 0xd2, 0x92, 0xc5, 0x55,
+	0x8a, 0x06, 0xe4, 0x36, 0x24, 0x6d, 0x35, 0x59, 0x91, 0xaa, 0xb9, 0x7a, 0xfe, 0xaf, 0xbe, 0x96,
+	0x09, 0xf7, 0x82, 0x9a, 0xbe, 0xa4, 0x5b, 0xdc, 0xa0, 0x28, 0x40, 0xde, 0x0d, 0xb1, 0xa7, 0xca,
+	0xcc, 0xc1, 0xe2, 0xe7, 0x5a, 0xe5, 0xd3, 0xaf, 0xb4, 0x99, 0xcf, 0x4e, 0xf6, 0x17, 0x47, 0xf7,
+	0x9a, 0x13, 0x85, 0xeb, 0xdf, 0x27, 0x40, 0x76, 0x0b, 0x39, 0xd0, 0xee, 0x35, 0xba, 0xca, 0x1c,
+	0x48, 0xf9, 0xd8, 0xb7, 0x11, 0x6f, 0x43, 0xb6, 0x84, 0xa0, 0xac, 0x83, 0x9c, 0x03, 0x19, 0x0a,
+	0xae, 0x2d, 0x2a, 0xcb, 0xd5, 0xef, 0xfe, 0xd6, 0xd7, 0xe6, 0x05, 0x20, 0xa4, 0xf5, 0xa1, 0xe1,
+	0x62, 0xd3, 0x83, 0xb4, 0x6d, 0x6c, 0xfa, 0xf4, 0xd9, 0xc1, 0x12, 0x88, 0x91, 0xda, 0xf4, 0xa9,
+	0x95, 0x75, 0x20, 0x79, 0xc4, 0x02, 0x95, 0x32, 0x48, 0x3a, 0x90, 0xf0, 0xf2, 0xe5, 0x7a, 0xe1,
+	0xa8, 0xaf, 0x65, 0x1f, 0x42, 0xb2, 0xe5, 0x7a, 0x2e, 0xb5, 0x98, 0x41, 0xb9, 0x09, 0x12, 0x14,
+	0xc7, 0xc5, 0x27, 0x28, 0x56, 0x1e, 0x82, 0x54, 0x04, 0xf7, 0x3a, 0x48, 0x4d, 0xf1, 0x1b, 0x97,
+	0x2f, 0xbc, 0xf1, 0xa8, 0xaf, 0xa5, 0x57, 0x3d, 0xdc, 0x99, 0xba, 0x5b, 0xc4, 0xb3, 0xb9, 0x70,
+	0x68, 0xd2, 0x15, 0xa9, 0x5a, 0x88, 0xe7, 0x5c, 0x00, 0x52, 0xa4, 0x66, 0xb8, 0x42, 0x8a, 0x98,
+	0x14, 0xaa, 0x59, 0x21, 0x85, 0x4c, 0x22, 0x6a, 0x4e, 0x48, 0xa4, 0xa6, 0xb1, 0x09, 0x5e, 0x02,
+	0x9c, 0xfe, 0x53, 0x12, 0x14, 0x56, 0x6d, 0x1b, 0x11, 0xb2, 0xe5, 0x12, 0xda, 0xe8, 0x2a, 0x6f,
+	0x81, 0xac, 0xdd, 0x86, 0xae, 0xdf, 0x74, 0x5b, 0x7c, 0x8e, 0xb9, 0xba, 0x79, 0x59, 0xed, 0x99,
+	0x35, 0xe6, 0xbc, 0xb9, 0x7e, 0xda, 0xd7, 0x32, 0xb6, 0x38, 0x5a, 0xf1, 0xa1, 0x35, 0x02, 0x24,
+	0x31, 0x0e, 0xc8, 0xeb, 0xe3, 0x80, 0x08, 0x3e, 0x14, 0x2f, 0xbc, 0x62, 0x1a, 0x02, 0xf9, 0x72,
+	0x08, 0x52, 0x43, 0x08, 0xee, 0x0f, 0x20, 0x48, 0xf3, 0x3b, 0x5e, 0xbe, 0x06, 0x04, 0x67, 0x87,
+	0x9e, 0x19, 0x1b, 0xfa, 0xfb, 0x20, 0x0b, 0xf9, 0xa0, 0x10, 0x51, 0xb3, 0x95, 0x64, 0x35, 0xbf,
+	0x72, 0xdb, 0x98, 0x9a, 0xaa, 0x18, 0x65, 0xa3, 0x13, 0xec, 0xa1, 0x7a, 0xe5, 0xb0, 0xaf, 0xcd,
+	0x9c, 0xf6, 0x35, 0x00, 0x87, 0xf3, 0xfd, 0xf6, 0x77, 0x0d, 0x8c, 0xa6, 0x6d, 0x0d, 0x13, 0x0a,
+	0x44, 0x73, 0x13, 0x88, 0x82, 0x09, 0x44, 0xf3, 0xd7, 0x46, 0xf4, 0x73, 0x19, 0x14, 0xd6, 0x7b,
+	0x3e, 0xf4, 0x5c, 0xfb, 0x01, 0x42, 0xff, 0x0b, 0xa2, 0xf7, 0x41, 0x9e, 0x21, 0x4a, 0xdd, 0xa0,
+	0x69, 0xc3, 0xe0, 0x6a, 0x4c, 0x19, 0xfe, 0x0d, 0x37, 0x58, 0x83, 0xc1, 0x20, 0x74, 0x17, 0x21,
+	0x1e, 0x2a, 0x5f, 0x27, 0xf4, 0x01, 0x42, 0x2c, 0x34, 0xe6, 0x43, 0xea, 0x72, 0x3e, 0xa4, 0xa7,
+	0xf9, 0x90, 0xf9, 0xc7, 0x7c, 0xc8, 0x5e, 0xc0, 0x87, 0xdc, 0x7f, 0xc2, 0x07, 0x30, 0xc1, 0x87,
+	0xfc, 0x04, 0x1f, 0x0a, 0xd7, 0xe6, 0x83, 0x0e, 0x4a, 0x1b, 0x5d, 0x8a, 0x7c, 0xe2, 0x62, 0xff,
+	0xed, 0x80, 0xbf, 0x1a, 0xa3, 0x05, 0x5a, 0x93, 0x59, 0xb8, 0xfe, 0xb5, 0x04, 0xe6, 0x27, 0x16,
+	0xab, 0x85, 0x48, 0x80, 0x7d, 0xc2, 0x3b, 0xe7, 0x7b, 0x5b, 0x12, 0x6b, 0x99, 0x9d, 0x95, 0x7b,
+	0x40, 0xde, 0xc3, 0x0e, 0x51, 0x13, 0xbc, 0xeb, 0xf9, 0xe9, 0xae, 0xb7, 0xb0, 0x63, 0x71, 0x17,
+	0x65, 0x16, 0x24, 0x43, 0x44, 0x39, 0x23, 0x0a, 0x16, 0x3b, 0x2a, 0x45, 0x90, 0x8d, 0xbc, 0x26,
+	0x0a, 0x43, 0x1c, 0xc6, 0xeb, 0x32, 0x13, 0x79, 0x1b, 0x4c, 0x64, 0x26, 0xc6, 0x85, 0x0e, 0x41,
+	0x2d, 0x81, 0xaa, 0x95, 0x71, 0x20, 0x79, 0x4c, 0x50, 0x2b, 0x2e, 0xf3, 0x3b, 0x09, 0xdc, 0xda,
+	0x26, 0xce, 0xe3, 0xa0, 0x05, 0x29, 0x7a, 0x04, 0x43, 0xe8, 0x11, 0xb6, 0x4d, 0x60, 0x87, 0xb6,
+	0x71, 0xe8, 0xd2, 0x5e, 0x4c, 0x6f, 0xf5, 0xd9, 0xc1, 0xd2, 0x5c, 0xbc, 0x49, 0x57, 0x5b, 0xad,
+	0x10, 0x11, 0xf2, 0x0e, 0x0d, 0x5d, 0xdf, 0xb1, 0x46, 0xae, 0xca, 0x1b, 0x20, 0x1d, 0xf0, 0x0c,
+	0x9c, 0xca, 0xf9, 0x15, 0x75, 0xba, 0x0d, 0x71, 0x43, 0x3d, 0xc7, 0x70, 0xfb, 0xe6, 0x64, 0x7f,
+	0x51, 0xb2, 0xe2, 0x90, 0xda, 0xca, 0x93, 0x93, 0xfd, 0xc5, 0x51, 0x32, 0xf6, 0x44, 0x69, 0xa3,
+	0x27, 0xaa, 0xcb, 0x1f, 0xf5, 0x33, 0x85, 0xea, 0x45, 0xb0, 0x70, 0x46, 0x35, 0x18, 0xb2, 0xfe,
+	0xb3, 0x04, 0x6e, 0x6e, 0x13, 0xc7, 0x42, 0x04, 0xd1, 0x7f, 0xd9, 0xd6, 0x1d, 0x70, 0x2b, 0x64,
+	0x69, 0x9a, 0x28, 0xf2, 0x9a, 0x2d, 0xe4, 0x63, 0x8f, 0xf7, 0x97, 0xb5, 0x6e, 0x70, 0xf5, 0x46,
+	0xe4, 0xad, 0x33, 0xa5, 0xf2, 0x2a, 0x50, 0x84, 0x9f, 0x58, 0x0d, 0x36, 0xf6, 0x77, 0x5d, 0x87,
+	0xe3, 0x94, 0xb5, 0x66, 0xb9, 0x85, 0xaf, 0x81, 0x35, 0xae, 0xaf, 0x2d, 0x4f, 0xf7, 0x5b, 0x3e,
+	0xa7, 0xdf, 0xb1, 0x06, 0x74, 0x15, 0x3c, 0x3f, 0xa9, 0x19, 0x74, 0xbb, 0xf2, 0x43, 0x02, 0x24,
+	0xb7, 0x89, 0xa3, 0x7c, 0x0c, 0xc0, 0xd8, 0x27, 0x88, 0x36, 0x3d, 0xff, 0x09, 0x46, 0x96, 0xee,
+	0x5e, 0xe1, 0x30, 0x9c, 0xe6, 0x2b, 0x4f, 0x7e, 0xf9, 0xf3, 0xcb, 0x84, 0xa6, 0xdf, 0x36, 0xa7,
+	0xbf, 0xb2, 0x62, 0xef, 0x26, 0xed, 0x2a, 0x1f, 0x80, 0xc2, 0x04, 0x91, 0x5e, 0x3a, 0x37, 0xff,
+	0xb8, 0x4b, 0xe9, 0xde, 0x95, 0x2e, 0xc3, 0xff, 0xcd, 0xbb, 0x20, 0x3f, 0x0e, 0x67, 0xe5, 0xdc,
+	0xc8, 0x31, 0x8f, 0x52, 0xf5, 0x2a, 0x8f, 0x41, 0xea, 0x52, 0xea, 0x13, 0xc6, 0xc5, 0xfa, 0x9b,
+	0x87, 0x47, 0x65, 0xe9, 0xe9, 0x51, 0x59, 0xfa, 0xe3, 0xa8, 0x2c, 0x7d, 0x71, 0x5c, 0x9e, 0x79,
+	0x7a, 0x5c, 0x9e, 0xf9, 0xf5, 0xb8, 0x3c, 0xf3, 0xde, 0x1d, 0xc7, 0xa5, 0xed, 0xce, 0x8e, 0x61,
+	0x63, 0x8f, 0x35, 0x8e, 0x89, 0x79, 0x16, 0x2b, 0xda, 0x0b, 0x10, 0xd9, 0x49, 0xf3, 0xef, 0xbb,
+	0xd7, 0xfe, 0x1e, 0x00, 0x53, 0x98, 0x69, 0xde, 0xef, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -540,6 +652,9 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResetParams defines a governance operation resetting the x/evm module parameters to their
+	// default values, the evm denom and the chain config are kept unless requested otherwise.
+	ResetParams(ctx context.Context, in *MsgResetParams, opts ...grpc.CallOption) (*MsgResetParamsResponse, error)
 }
 
 type msgClient struct {
@@ -568,6 +683,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ResetParams(ctx context.Context, in *MsgResetParams, opts ...grpc.CallOption) (*MsgResetParamsResponse, error) {
+	out := new(MsgResetParamsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/ResetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -575,6 +699,9 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResetParams defines a governance operation resetting the x/evm module parameters to their
+	// default values, the evm denom and the chain config are kept unless requested otherwise.
+	ResetParams(context.Context, *MsgResetParams) (*MsgResetParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -587,6 +714,9 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ResetParams(ctx context.Context, req *MsgResetParams) (*MsgResetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -628,6 +758,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/ResetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetParams(ctx, req.(*MsgResetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -640,6 +788,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ResetParams",
+			Handler:    _Msg_ResetParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1189,6 +1341,79 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetChainConfig {
+		i--
+		if m.ResetChainConfig {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ResetEvmDenom {
+		i--
+		if m.ResetEvmDenom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1440,6 +1665,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgResetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ResetEvmDenom {
+		n += 2
+	}
+	if m.ResetChainConfig {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResetParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3183,6 +3436,178 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgResetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetEvmDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetEvmDenom = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetChainConfig", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetChainConfig = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
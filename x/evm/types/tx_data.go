@@ -22,6 +22,9 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+// BlobTxType is the EIP-4844 blob transaction type, which is not supported yet
+const BlobTxType = 0x03
+
 var (
 	_ TxData = &LegacyTx{}
 	_ TxData = &AccessListTx{}
@@ -36,6 +36,15 @@ var DefaultPriorityReduction = sdk.DefaultPowerReduction
 
 var EmptyCodeHash = crypto.Keccak256(nil)
 
+// IsContractCodeHash returns true if the given code hash belongs to an account with code, i.e it is
+// neither blank nor the hash of the empty code.
+func IsContractCodeHash(codeHash common.Hash) bool {
+	return codeHash != (common.Hash{}) && codeHash != common.BytesToHash(EmptyCodeHash)
+}
+
+// PrecompileCode is the placeholder code set on precompile accounts, so they're never empty
+var PrecompileCode = []byte{0x01}
+
 // DecodeTxResponse decodes an protobuf-encoded byte slice into TxResponse
 func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	var txMsgData sdk.TxMsgData
@@ -113,6 +122,21 @@ func BinSearch(lo, hi uint64, executable func(uint64) (bool, *MsgEthereumTxRespo
 
 // EffectiveGasPrice compute the effective gas price based on eip-1159 rules
 // `effectiveGasPrice = min(baseFee + tipCap, feeCap)`
+//
+// Legacy txs only specify a gas price, so a nil cap defaults to the other one, which is
+// expected to be the gas price, and a nil base fee (i.e. pre-London) returns the fee cap.
 func EffectiveGasPrice(baseFee *big.Int, feeCap *big.Int, tipCap *big.Int) *big.Int {
+	if feeCap == nil {
+		feeCap = tipCap
+	}
+	if tipCap == nil {
+		tipCap = feeCap
+	}
+	if feeCap == nil {
+		return new(big.Int)
+	}
+	if baseFee == nil {
+		return new(big.Int).Set(feeCap)
+	}
 	return math.BigMin(new(big.Int).Add(tipCap, baseFee), feeCap)
 }
@@ -87,6 +87,29 @@ func TestBinSearch(t *testing.T) {
 	require.Equal(t, gas, uint64(0))
 }
 
+func TestEffectiveGasPrice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseFee  *big.Int
+		feeCap   *big.Int
+		tipCap   *big.Int
+		expPrice *big.Int
+	}{
+		{"legacy tx, nil caps", big.NewInt(10), nil, nil, big.NewInt(0)},
+		{"legacy tx, gas price as fee cap", big.NewInt(10), big.NewInt(50), nil, big.NewInt(50)},
+		{"legacy tx, gas price as tip cap", big.NewInt(10), nil, big.NewInt(50), big.NewInt(50)},
+		{"legacy tx, gas price below base fee + tip", big.NewInt(60), big.NewInt(50), big.NewInt(50), big.NewInt(50)},
+		{"dynamic fee tx, fee cap binds", big.NewInt(100), big.NewInt(105), big.NewInt(10), big.NewInt(105)},
+		{"dynamic fee tx, tip binds", big.NewInt(100), big.NewInt(200), big.NewInt(10), big.NewInt(110)},
+		{"dynamic fee tx, nil base fee", nil, big.NewInt(200), big.NewInt(10), big.NewInt(200)},
+	}
+
+	for _, tc := range testCases {
+		price := evmtypes.EffectiveGasPrice(tc.baseFee, tc.feeCap, tc.tipCap)
+		require.Equal(t, tc.expPrice, price, tc.name)
+	}
+}
+
 func TestTransactionLogsEncodeDecode(t *testing.T) {
 	addr := tests.GenerateAddress().String()
 
@@ -45,3 +45,28 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestEndBlockGasWantedRollover() {
+	suite.SetupTest()
+
+	committed, err := suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000)))
+	_, err = suite.app.FeeMarketKeeper.AddTransientGasWanted(suite.ctx, 3000000)
+	suite.Require().NoError(err)
+	_, err = suite.app.FeeMarketKeeper.AddTransientGasWanted(suite.ctx, 2000000)
+	suite.Require().NoError(err)
+
+	// mid-block, only the transient value accumulates
+	suite.Require().Equal(uint64(5000000), suite.app.FeeMarketKeeper.GetTransientGasWanted(suite.ctx))
+	gasWanted, err := suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(committed, gasWanted)
+
+	// EndBlock commits it, limited by the MinGasMultiplier (0.5 by default)
+	suite.Require().NoError(suite.app.FeeMarketKeeper.EndBlocker(suite.ctx))
+	gasWanted, err = suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2500000), gasWanted)
+}
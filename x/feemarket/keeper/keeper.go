@@ -89,7 +89,9 @@ func (k Keeper) SetBlockGasWanted(ctx sdk.Context, gas uint64) error {
 	return nil
 }
 
-// GetBlockGasWanted returns the last block gas wanted value from the store.
+// GetBlockGasWanted returns the last block gas wanted value from the store. It's the value
+// committed by EndBlock, i.e the gas wanted of the previous block until the EndBlock of the
+// current one, and is the parent gas used in the base fee calculation of the next block.
 func (k Keeper) GetBlockGasWanted(ctx sdk.Context) (uint64, error) {
 	store := k.storeService.OpenKVStore(ctx)
 	bz, err := store.Get(types.KeyPrefixBlockGasWanted)
@@ -100,7 +102,8 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) (uint64, error) {
 	return sdk.BigEndianToUint64(bz), nil
 }
 
-// GetTransientGasWanted returns the gas wanted in the current block from transient store.
+// GetTransientGasWanted returns the gas wanted accumulated so far in the current block from the
+// transient store. It's reset on Commit, after EndBlock stores it as the block gas wanted.
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
 	bz := store.Get(types.KeyPrefixTransientBlockGasWanted)